@@ -0,0 +1,179 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// databaseEngineOptions indexes the options catalog by engine slug.
+func databaseEngineOptions(options *godo.DatabaseOptions) map[string]godo.DatabaseEngineOptions {
+	return map[string]godo.DatabaseEngineOptions{
+		"pg":             options.PostgresSQLOptions,
+		"mysql":          options.MySQLOptions,
+		"redis":          options.RedisOptions,
+		"valkey":         options.ValkeyOptions,
+		"mongodb":        options.MongoDBOptions,
+		"kafka":          options.KafkaOptions,
+		"opensearch":     options.OpensearchOptions,
+		"advanced_pg":    options.AdvancedPostgresSQLOptions,
+		"advanced_mysql": options.AdvancedMySQLOptions,
+	}
+}
+
+// validateDatabaseClusterOptions rejects a cluster whose engine, version,
+// region, size and node count are not offered together, rather than failing
+// after a long create. Only changed attributes are checked, so clusters on
+// versions or sizes since removed from the catalog can still be updated.
+func validateDatabaseClusterOptions(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.HasChanges("engine", "version", "region", "size", "node_count") {
+		return nil
+	}
+
+	for _, k := range []string{"engine", "version", "region", "size", "node_count"} {
+		if !d.NewValueKnown(k) {
+			return nil
+		}
+	}
+
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	options, _, err := client.Databases.ListOptions(ctx)
+	if err != nil {
+		return fmt.Errorf("Error retrieving database options: %s", err)
+	}
+
+	engine := d.Get("engine").(string)
+	cluster := databaseOptionsRequest{engine: engine}
+	if d.HasChange("engine") || d.HasChange("version") {
+		cluster.version = d.Get("version").(string)
+	}
+	if d.HasChange("engine") || d.HasChange("region") {
+		cluster.region = strings.ToLower(d.Get("region").(string))
+	}
+	if d.HasChange("engine") || d.HasChanges("size", "node_count") {
+		cluster.size = d.Get("size").(string)
+		cluster.nodeCount = d.Get("node_count").(int)
+	}
+
+	return checkDatabaseOptionsSupported(cluster, options)
+}
+
+// validateDatabaseReplicaOptions rejects a replica whose size or region is
+// not offered for the engine of its cluster.
+func validateDatabaseReplicaOptions(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.HasChanges("region", "size") {
+		return nil
+	}
+
+	if !d.NewValueKnown("cluster_id") || !d.NewValueKnown("region") || !d.NewValueKnown("size") {
+		return nil
+	}
+
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	clusterID := d.Get("cluster_id").(string)
+	cluster, _, err := client.Databases.Get(ctx, clusterID)
+	if err != nil {
+		return fmt.Errorf("Error retrieving database cluster (%s): %s", clusterID, err)
+	}
+
+	options, _, err := client.Databases.ListOptions(ctx)
+	if err != nil {
+		return fmt.Errorf("Error retrieving database options: %s", err)
+	}
+
+	replica := databaseOptionsRequest{engine: cluster.EngineSlug}
+	if d.HasChange("region") {
+		replica.region = strings.ToLower(d.Get("region").(string))
+	}
+	if d.HasChange("size") {
+		replica.size = d.Get("size").(string)
+	}
+
+	return checkDatabaseOptionsSupported(replica, options)
+}
+
+// databaseOptionsRequest holds the attributes to check against the options
+// catalog. Zero values are not checked. A size without a node count is
+// checked against the sizes of all layouts.
+type databaseOptionsRequest struct {
+	engine    string
+	version   string
+	region    string
+	size      string
+	nodeCount int
+}
+
+func checkDatabaseOptionsSupported(req databaseOptionsRequest, options *godo.DatabaseOptions) error {
+	engines := databaseEngineOptions(options)
+
+	engineOptions, ok := engines[req.engine]
+	if !ok {
+		slugs := make([]string, 0, len(engines))
+		for slug := range engines {
+			slugs = append(slugs, slug)
+		}
+		sort.Strings(slugs)
+
+		return fmt.Errorf("engine %s is not supported, supported engines are %s", req.engine, strings.Join(slugs, ", "))
+	}
+
+	// An engine missing from the catalog is left for the API to reject.
+	if len(engineOptions.Versions) == 0 && len(engineOptions.Regions) == 0 && len(engineOptions.Layouts) == 0 {
+		return nil
+	}
+
+	if req.version != "" && !databaseVersionSupported(engineOptions.Versions, req.version) {
+		return fmt.Errorf("version %s is not supported for engine %s, supported versions are %s", req.version, req.engine, strings.Join(engineOptions.Versions, ", "))
+	}
+
+	if req.region != "" && !slices.Contains(engineOptions.Regions, req.region) {
+		return fmt.Errorf("region %s does not support engine %s, supported regions are %s", req.region, req.engine, strings.Join(engineOptions.Regions, ", "))
+	}
+
+	if req.nodeCount != 0 {
+		nodeCounts := make([]string, 0, len(engineOptions.Layouts))
+		for _, layout := range engineOptions.Layouts {
+			nodeCounts = append(nodeCounts, strconv.Itoa(layout.NodeNum))
+
+			if layout.NodeNum != req.nodeCount {
+				continue
+			}
+
+			if req.size != "" && !slices.Contains(layout.Sizes, req.size) {
+				return fmt.Errorf("size %s is not supported for engine %s with %d nodes, supported sizes are %s", req.size, req.engine, req.nodeCount, strings.Join(layout.Sizes, ", "))
+			}
+
+			return nil
+		}
+
+		return fmt.Errorf("node_count %d is not supported for engine %s, supported node counts are %s", req.nodeCount, req.engine, strings.Join(nodeCounts, ", "))
+	}
+
+	if req.size != "" {
+		supported := slices.ContainsFunc(engineOptions.Layouts, func(layout godo.DatabaseLayout) bool {
+			return slices.Contains(layout.Sizes, req.size)
+		})
+		if !supported {
+			return fmt.Errorf("size %s is not supported for engine %s", req.size, req.engine)
+		}
+	}
+
+	return nil
+}
+
+// databaseVersionSupported compares versions ignoring a trailing ".0", as the
+// catalog may list "6.0" for a cluster created with version "6".
+func databaseVersionSupported(versions []string, version string) bool {
+	return slices.ContainsFunc(versions, func(v string) bool {
+		return strings.TrimSuffix(v, ".0") == strings.TrimSuffix(version, ".0")
+	})
+}
@@ -0,0 +1,57 @@
+package database
+
+import (
+	"testing"
+
+	"github.com/digitalocean/godo"
+)
+
+func TestCheckDatabaseOptionsSupported(t *testing.T) {
+	options := &godo.DatabaseOptions{
+		PostgresSQLOptions: godo.DatabaseEngineOptions{
+			Regions:  []string{"nyc1", "fra1"},
+			Versions: []string{"15", "16"},
+			Layouts: []godo.DatabaseLayout{
+				{NodeNum: 1, Sizes: []string{"db-s-1vcpu-1gb", "db-s-1vcpu-2gb"}},
+				{NodeNum: 2, Sizes: []string{"db-s-1vcpu-2gb"}},
+			},
+		},
+		MongoDBOptions: godo.DatabaseEngineOptions{
+			Regions:  []string{"nyc1"},
+			Versions: []string{"6.0", "7.0"},
+			Layouts: []godo.DatabaseLayout{
+				{NodeNum: 3, Sizes: []string{"db-s-1vcpu-1gb"}},
+			},
+		},
+	}
+
+	cases := []struct {
+		name        string
+		req         databaseOptionsRequest
+		expectError bool
+	}{
+		{name: "supported", req: databaseOptionsRequest{engine: "pg", version: "16", region: "fra1", size: "db-s-1vcpu-2gb", nodeCount: 2}},
+		{name: "unknown engine", req: databaseOptionsRequest{engine: "oracle"}, expectError: true},
+		{name: "engine missing from catalog", req: databaseOptionsRequest{engine: "kafka", version: "3.7", nodeCount: 3}},
+		{name: "unsupported version", req: databaseOptionsRequest{engine: "pg", version: "12"}, expectError: true},
+		{name: "version without trailing zero", req: databaseOptionsRequest{engine: "mongodb", version: "7"}},
+		{name: "unsupported region", req: databaseOptionsRequest{engine: "pg", region: "sgp1"}, expectError: true},
+		{name: "unsupported node count", req: databaseOptionsRequest{engine: "pg", size: "db-s-1vcpu-2gb", nodeCount: 3}, expectError: true},
+		{name: "size not offered for node count", req: databaseOptionsRequest{engine: "pg", size: "db-s-1vcpu-1gb", nodeCount: 2}, expectError: true},
+		{name: "replica size", req: databaseOptionsRequest{engine: "pg", size: "db-s-1vcpu-1gb"}},
+		{name: "unsupported replica size", req: databaseOptionsRequest{engine: "pg", size: "db-s-8vcpu-16gb"}, expectError: true},
+		{name: "nothing changed", req: databaseOptionsRequest{engine: "pg"}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := checkDatabaseOptionsSupported(tc.req, options)
+			if tc.expectError && err == nil {
+				t.Error("expected an error")
+			}
+			if !tc.expectError && err != nil {
+				t.Errorf("unexpected error: %s", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,98 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/digitalocean/terraform-provider-digitalocean/internal/datalist"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func DataSourceDigitalOceanDatabaseBackups() *schema.Resource {
+	dataListConfig := &datalist.ResourceConfig{
+		RecordSchema: map[string]*schema.Schema{
+			"created_at": {
+				Type:        schema.TypeString,
+				Description: "time the backup was created, in RFC3339 format",
+			},
+			"size_gigabytes": {
+				Type:        schema.TypeFloat,
+				Description: "size of the backup in gigabytes",
+			},
+		},
+		ResultAttributeName: "backups",
+		FlattenRecord:       flattenDigitalOceanDatabaseBackup,
+		GetRecords:          getDigitalOceanDatabaseBackups,
+		ExtraQuerySchema: map[string]*schema.Schema{
+			"cluster_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+		},
+	}
+
+	return datalist.NewResource(dataListConfig)
+}
+
+func getDigitalOceanDatabaseBackups(meta interface{}, extra map[string]interface{}) ([]interface{}, error) {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	clusterID := extra["cluster_id"].(string)
+
+	backups, err := listDatabaseBackups(context.Background(), client, clusterID)
+	if err != nil {
+		return nil, err
+	}
+
+	backupList := make([]interface{}, 0, len(backups))
+	for _, backup := range backups {
+		backupList = append(backupList, backup)
+	}
+
+	return backupList, nil
+}
+
+func flattenDigitalOceanDatabaseBackup(rawBackup, meta interface{}, extra map[string]interface{}) (map[string]interface{}, error) {
+	backup := rawBackup.(godo.DatabaseBackup)
+
+	return map[string]interface{}{
+		"created_at":     backup.CreatedAt.UTC().Format(time.RFC3339),
+		"size_gigabytes": backup.SizeGigabytes,
+	}, nil
+}
+
+func listDatabaseBackups(ctx context.Context, client *godo.Client, clusterID string) ([]godo.DatabaseBackup, error) {
+	var backups []godo.DatabaseBackup
+
+	opts := &godo.ListOptions{
+		Page:    1,
+		PerPage: 200,
+	}
+
+	for {
+		partialBackups, resp, err := client.Databases.ListBackups(ctx, clusterID, opts)
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving backups for database cluster (%s): %s", clusterID, err)
+		}
+
+		backups = append(backups, partialBackups...)
+
+		if resp.Links == nil || resp.Links.IsLastPage() {
+			break
+		}
+
+		page, err := resp.Links.CurrentPage()
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving backups for database cluster (%s): %s", clusterID, err)
+		}
+
+		opts.Page = page + 1
+	}
+
+	return backups, nil
+}
@@ -0,0 +1,76 @@
+package database_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccDataSourceDigitalOceanDatabaseBackups_Basic(t *testing.T) {
+	var database godo.Database
+
+	databaseName := acceptance.RandomTestName()
+	backupDatabaseName := acceptance.RandomTestName()
+
+	databaseConfig := fmt.Sprintf(testAccCheckDigitalOceanDatabaseClusterConfigBasic, databaseName)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanDatabaseClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: databaseConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDigitalOceanDatabaseClusterExists("digitalocean_database_cluster.foobar", &database),
+					func(s *terraform.State) error {
+						return waitForDatabaseBackups(databaseName)
+					},
+				),
+			},
+			{
+				Config: databaseConfig + testAccCheckDataSourceDigitalOceanDatabaseBackupsConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.digitalocean_database_backups.foobar", "backups.0.created_at"),
+					resource.TestCheckResourceAttrSet("data.digitalocean_database_backups.foobar", "backups.0.size_gigabytes"),
+				),
+			},
+			{
+				// Restoring to a point before the oldest backup is rejected at plan.
+				Config:      databaseConfig + fmt.Sprintf(testAccCheckDigitalOceanDatabaseClusterConfigWithBackupRestoreAt, backupDatabaseName, databaseName, "2020-01-01T00:00:00Z"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`is before the oldest available backup`),
+			},
+		},
+	})
+}
+
+const testAccCheckDataSourceDigitalOceanDatabaseBackupsConfig = `
+data "digitalocean_database_backups" "foobar" {
+  cluster_id = digitalocean_database_cluster.foobar.id
+
+  sort {
+    key       = "created_at"
+    direction = "desc"
+  }
+}`
+
+const testAccCheckDigitalOceanDatabaseClusterConfigWithBackupRestoreAt = `
+resource "digitalocean_database_cluster" "foobar_backup" {
+  name       = "%s"
+  engine     = "pg"
+  version    = "15"
+  size       = "db-s-1vcpu-2gb"
+  region     = "nyc1"
+  node_count = 1
+
+  backup_restore {
+    database_name     = "%s"
+    backup_created_at = "%s"
+  }
+}`
@@ -0,0 +1,142 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/digitalocean/terraform-provider-digitalocean/internal/datalist"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func DataSourceDigitalOceanDatabaseEvents() *schema.Resource {
+	dataListConfig := &datalist.ResourceConfig{
+		RecordSchema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Description: "ID of the event",
+			},
+			"cluster_name": {
+				Type:        schema.TypeString,
+				Description: "name of the database cluster the event occurred on",
+			},
+			"event_type": {
+				Type:        schema.TypeString,
+				Description: "type of the event",
+			},
+			"create_time": {
+				Type:        schema.TypeString,
+				Description: "time the event occurred",
+			},
+		},
+		ResultAttributeName: "events",
+		FlattenRecord:       flattenDigitalOceanDatabaseEvent,
+		GetRecordsContext:   getDigitalOceanDatabaseEvents,
+		ExtraQuerySchema: map[string]*schema.Schema{
+			"cluster_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"since": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+				Description:  "only return events which occurred at or after this time",
+			},
+		},
+	}
+
+	return datalist.NewResource(dataListConfig)
+}
+
+func getDigitalOceanDatabaseEvents(ctx context.Context, meta interface{}, extra map[string]interface{}) ([]interface{}, error) {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	clusterID := extra["cluster_id"].(string)
+
+	var since time.Time
+	if v := extra["since"].(string); v != "" {
+		var err error
+		since, err = time.Parse(time.RFC3339, v)
+		if err != nil {
+			return nil, fmt.Errorf("Error parsing since: %s", err)
+		}
+	}
+
+	events, err := listDatabaseEvents(ctx, client, clusterID)
+	if err != nil {
+		return nil, err
+	}
+
+	eventList := make([]interface{}, 0, len(events))
+	for _, event := range filterDatabaseEventsSince(events, since) {
+		eventList = append(eventList, event)
+	}
+
+	return eventList, nil
+}
+
+func listDatabaseEvents(ctx context.Context, client *godo.Client, clusterID string) ([]godo.DatabaseEvent, error) {
+	var events []godo.DatabaseEvent
+
+	opts := &godo.ListOptions{
+		Page:    1,
+		PerPage: 200,
+	}
+
+	for {
+		partialEvents, resp, err := client.Databases.ListDatabaseEvents(ctx, clusterID, opts)
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving events for database cluster (%s): %s", clusterID, err)
+		}
+
+		events = append(events, partialEvents...)
+
+		if resp.Links == nil || resp.Links.IsLastPage() {
+			break
+		}
+
+		page, err := resp.Links.CurrentPage()
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving events for database cluster (%s): %s", clusterID, err)
+		}
+
+		opts.Page = page + 1
+	}
+
+	return events, nil
+}
+
+// filterDatabaseEventsSince returns the events which occurred at or after
+// since. Events with a creation time which cannot be parsed are kept.
+func filterDatabaseEventsSince(events []godo.DatabaseEvent, since time.Time) []godo.DatabaseEvent {
+	if since.IsZero() {
+		return events
+	}
+
+	var filtered []godo.DatabaseEvent
+	for _, event := range events {
+		createdAt, err := time.Parse(time.RFC3339, event.CreateTime)
+		if err == nil && createdAt.Before(since) {
+			continue
+		}
+		filtered = append(filtered, event)
+	}
+
+	return filtered
+}
+
+func flattenDigitalOceanDatabaseEvent(rawEvent, meta interface{}, extra map[string]interface{}) (map[string]interface{}, error) {
+	event := rawEvent.(godo.DatabaseEvent)
+
+	return map[string]interface{}{
+		"id":           event.ID,
+		"cluster_name": event.ServiceName,
+		"event_type":   event.EventType,
+		"create_time":  event.CreateTime,
+	}, nil
+}
@@ -0,0 +1,63 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFilterDatabaseEventsSince(t *testing.T) {
+	events := []godo.DatabaseEvent{
+		{ID: "1", EventType: "cluster_create", CreateTime: "2024-06-01T10:00:00Z"},
+		{ID: "2", EventType: "cluster_maintenance_perform", CreateTime: "2024-06-08T02:00:00Z"},
+		{ID: "3", EventType: "cluster_failover", CreateTime: "2024-06-09T13:45:00Z"},
+		{ID: "4", EventType: "cluster_update", CreateTime: "unknown"},
+	}
+
+	assert.Equal(t, events, filterDatabaseEventsSince(events, time.Time{}))
+
+	since := time.Date(2024, 6, 8, 2, 0, 0, 0, time.UTC)
+	assert.Equal(t, []godo.DatabaseEvent{events[1], events[2], events[3]}, filterDatabaseEventsSince(events, since))
+
+	since = time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC)
+	assert.Equal(t, []godo.DatabaseEvent{events[3]}, filterDatabaseEventsSince(events, since))
+}
+
+func TestListDatabaseEvents(t *testing.T) {
+	clusterID := "3f2549b8-9257-4e2f-a04c-e23547b2d685"
+
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	mux.HandleFunc(fmt.Sprintf("/v2/databases/%s/events", clusterID), func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "1", r.URL.Query().Get("page"))
+		assert.Equal(t, "200", r.URL.Query().Get("per_page"))
+		fmt.Fprint(w, `{"events": [{"id": "a", "event_type": "cluster_create"}, {"id": "b", "event_type": "cluster_update"}]}`)
+	})
+
+	cfg := &config.Config{
+		Token:       "test-token",
+		APIEndpoint: server.URL,
+	}
+	combined, err := cfg.Client()
+	if err != nil {
+		t.Fatalf("error building client: %s", err)
+	}
+
+	events, err := listDatabaseEvents(context.Background(), combined.GodoClient(), clusterID)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if assert.Len(t, events, 2) {
+		assert.Equal(t, "a", events[0].ID)
+		assert.Equal(t, "b", events[1].ID)
+	}
+}
@@ -0,0 +1,56 @@
+package database_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceDigitalOceanDatabaseEvents_Basic(t *testing.T) {
+	var database godo.Database
+
+	databaseName := acceptance.RandomTestName()
+	databaseConfig := fmt.Sprintf(testAccCheckDigitalOceanDatabaseClusterConfigBasic, databaseName)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanDatabaseClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: databaseConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDigitalOceanDatabaseClusterExists("digitalocean_database_cluster.foobar", &database),
+				),
+			},
+			{
+				Config: databaseConfig + testAccCheckDataSourceDigitalOceanDatabaseEventsConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.digitalocean_database_events.foobar", "events.0.event_type", "cluster_create"),
+					resource.TestCheckResourceAttr("data.digitalocean_database_events.foobar", "events.0.cluster_name", databaseName),
+					resource.TestCheckResourceAttrSet("data.digitalocean_database_events.foobar", "events.0.id"),
+					resource.TestCheckResourceAttrSet("data.digitalocean_database_events.foobar", "events.0.create_time"),
+					resource.TestCheckResourceAttr("data.digitalocean_database_events.since", "events.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+const testAccCheckDataSourceDigitalOceanDatabaseEventsConfig = `
+data "digitalocean_database_events" "foobar" {
+  cluster_id = digitalocean_database_cluster.foobar.id
+
+  filter {
+    key    = "event_type"
+    values = ["cluster_create"]
+  }
+}
+
+data "digitalocean_database_events" "since" {
+  cluster_id = digitalocean_database_cluster.foobar.id
+  since      = "2100-01-01T00:00:00Z"
+}`
@@ -0,0 +1,249 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/monitoring"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// Host-level metrics are aggregated across the nodes of a cluster by the API.
+var databaseHostMetrics = []string{
+	"cpu_usage",
+	"memory_usage",
+	"disk_usage",
+	"load_1",
+	"load_5",
+	"load_15",
+}
+
+// Service-level metrics require a service name.
+var databaseServiceMetrics = []string{
+	"threads_connected",
+	"threads_created_rate",
+	"threads_active",
+	"index_vs_sequential_reads",
+	"op_rates",
+}
+
+// Schema-level metrics require both a service and schema name.
+var databaseSchemaMetrics = []string{
+	"schema_throughput",
+	"schema_latency",
+}
+
+func DataSourceDigitalOceanDatabaseMetrics() *schema.Resource {
+	var metricNames []string
+	metricNames = append(metricNames, databaseHostMetrics...)
+	metricNames = append(metricNames, databaseServiceMetrics...)
+	metricNames = append(metricNames, databaseSchemaMetrics...)
+
+	dsSchema := monitoring.MetricsSchema()
+	dsSchema["cluster_id"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		ValidateFunc: validation.NoZeroValues,
+		Description:  "The ID of the database cluster.",
+	}
+	dsSchema["metric"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		ValidateFunc: validation.StringInSlice(metricNames, false),
+		Description:  "The name of the metric to query.",
+	}
+	dsSchema["service"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.NoZeroValues,
+		Description:  "The service to query. Required for service and schema level metrics.",
+	}
+	dsSchema["schema"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.NoZeroValues,
+		Description:  "The schema to query. Required for schema level metrics.",
+	}
+	dsSchema["operation"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.StringInSlice([]string{"select", "insert", "update", "delete", "fetch"}, false),
+		Description:  "The operation to query. Required for the op_rates, schema_throughput, and schema_latency metrics.",
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceDigitalOceanDatabaseMetricsRead,
+		Schema:      dsSchema,
+	}
+}
+
+func dataSourceDigitalOceanDatabaseMetricsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	start, end, err := monitoring.ExpandMetricsTimeRange(d)
+	if err != nil {
+		return diag.Errorf("Invalid time range: %s", err)
+	}
+
+	clusterID := d.Get("cluster_id").(string)
+	metric := d.Get("metric").(string)
+
+	// The metrics endpoints only cover MySQL, so other engines would silently
+	// return no data.
+	db, _, err := client.Databases.Get(ctx, clusterID)
+	if err != nil {
+		return diag.Errorf("Error retrieving database cluster (%s): %s", clusterID, err)
+	}
+	if db.EngineSlug != mysqlDBEngineSlug {
+		return diag.Errorf("Database cluster (%s) uses the %s engine, but metrics are only supported for %s clusters", clusterID, db.EngineSlug, mysqlDBEngineSlug)
+	}
+
+	resp, err := getDatabaseMetrics(ctx, client, d, start, end)
+	if err != nil {
+		return diag.Errorf("Error retrieving %s metrics for database cluster (%s): %s", metric, clusterID, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s-%s-%d-%d", clusterID, metric, start.Unix(), end.Unix()))
+
+	if err := monitoring.SetMetricsAttributes(d, resp); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func getDatabaseMetrics(ctx context.Context, client *godo.Client, d *schema.ResourceData, start, end time.Time) (*godo.MetricsResponse, error) {
+	metric := d.Get("metric").(string)
+	service := d.Get("service").(string)
+	schemaName := d.Get("schema").(string)
+	operation := d.Get("operation").(string)
+	aggregation := d.Get("aggregation").(string)
+
+	host := godo.DbaasMysqlMetricsRequest{
+		DBID:  d.Get("cluster_id").(string),
+		Start: start,
+		End:   end,
+	}
+
+	// The API aggregates host-level metrics across the nodes of the cluster.
+	// It does not support summing them, so fall back to the average.
+	hostAggregate := aggregation
+	if hostAggregate == monitoring.MetricsAggregationSum {
+		hostAggregate = monitoring.MetricsAggregationAvg
+	}
+
+	if err := validateDatabaseMetricsArgs(metric, service, schemaName, operation, hostAggregate); err != nil {
+		return nil, err
+	}
+
+	svc := godo.DbaasMysqlServiceMetricsRequest{
+		DBID:    host.DBID,
+		Service: service,
+		Start:   host.Start,
+		End:     host.End,
+	}
+
+	sch := godo.DbaasMysqlSchemaMetricsRequest{
+		DBID:    host.DBID,
+		Service: service,
+		Schema:  schemaName,
+		Start:   host.Start,
+		End:     host.End,
+	}
+
+	var (
+		resp *godo.MetricsResponse
+		err  error
+	)
+	switch metric {
+	case "cpu_usage":
+		resp, _, err = client.Monitoring.GetDbaasMysqlCpuUsage(ctx, &godo.DbaasMysqlCpuUsageRequest{
+			DbaasMysqlMetricsRequest: host,
+			Aggregate:                hostAggregate,
+		})
+	case "memory_usage":
+		resp, _, err = client.Monitoring.GetDbaasMysqlMemoryUsage(ctx, &godo.DbaasMysqlMemoryUsageRequest{
+			DbaasMysqlMetricsRequest: host,
+			Aggregate:                hostAggregate,
+		})
+	case "disk_usage":
+		resp, _, err = client.Monitoring.GetDbaasMysqlDiskUsage(ctx, &godo.DbaasMysqlDiskUsageRequest{
+			DbaasMysqlMetricsRequest: host,
+			Aggregate:                hostAggregate,
+		})
+	case "load_1", "load_5", "load_15":
+		resp, _, err = client.Monitoring.GetDbaasMysqlLoad(ctx, &godo.DbaasMysqlLoadRequest{
+			DbaasMysqlMetricsRequest: host,
+			Metric:                   databaseLoadMetrics[metric],
+			Aggregate:                hostAggregate,
+		})
+	case "threads_connected":
+		resp, _, err = client.Monitoring.GetDbaasMysqlThreadsConnected(ctx, &svc)
+	case "threads_created_rate":
+		resp, _, err = client.Monitoring.GetDbaasMysqlThreadsCreatedRate(ctx, &svc)
+	case "threads_active":
+		resp, _, err = client.Monitoring.GetDbaasMysqlThreadsActive(ctx, &svc)
+	case "index_vs_sequential_reads":
+		resp, _, err = client.Monitoring.GetDbaasMysqlIndexVsSequentialReads(ctx, &svc)
+	case "op_rates":
+		resp, _, err = client.Monitoring.GetDbaasMysqlOpRates(ctx, &godo.DbaasMysqlOpRatesRequest{
+			DbaasMysqlServiceMetricsRequest: svc,
+			Metric:                          operation,
+		})
+	case "schema_throughput":
+		resp, _, err = client.Monitoring.GetDbaasMysqlSchemaThroughput(ctx, &godo.DbaasMysqlSchemaThroughputRequest{
+			DbaasMysqlSchemaMetricsRequest: sch,
+			Metric:                         operation,
+		})
+	case "schema_latency":
+		resp, _, err = client.Monitoring.GetDbaasMysqlSchemaLatency(ctx, &godo.DbaasMysqlSchemaLatencyRequest{
+			DbaasMysqlSchemaMetricsRequest: sch,
+			Metric:                         operation,
+		})
+	default:
+		return nil, fmt.Errorf("unsupported metric %q", metric)
+	}
+
+	return resp, err
+}
+
+var databaseLoadMetrics = map[string]string{
+	"load_1":  "load1",
+	"load_5":  "load5",
+	"load_15": "load15",
+}
+
+// validateDatabaseMetricsArgs checks that the arguments required by the
+// chosen metric are set, as the requirements differ between metrics.
+func validateDatabaseMetricsArgs(metric, service, schemaName, operation, hostAggregate string) error {
+	if slices.Contains(databaseServiceMetrics, metric) && service == "" {
+		return fmt.Errorf("service must be set for %s", metric)
+	}
+
+	if slices.Contains(databaseSchemaMetrics, metric) && (service == "" || schemaName == "") {
+		return fmt.Errorf("service and schema must be set for %s", metric)
+	}
+
+	switch metric {
+	case "load_1", "load_5", "load_15":
+		if hostAggregate == monitoring.MetricsAggregationMin {
+			return fmt.Errorf("aggregation %q is not supported for %s", hostAggregate, metric)
+		}
+	case "op_rates":
+		if operation == "" || operation == "fetch" {
+			return fmt.Errorf("operation must be one of select, insert, update, or delete for %s", metric)
+		}
+	case "schema_throughput", "schema_latency":
+		if operation == "" || operation == "select" {
+			return fmt.Errorf("operation must be one of fetch, insert, update, or delete for %s", metric)
+		}
+	}
+
+	return nil
+}
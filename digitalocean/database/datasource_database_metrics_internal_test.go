@@ -0,0 +1,84 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateDatabaseMetricsArgs(t *testing.T) {
+	cases := []struct {
+		name          string
+		metric        string
+		service       string
+		schema        string
+		operation     string
+		hostAggregate string
+		expectError   bool
+	}{
+		{name: "host metric", metric: "cpu_usage", hostAggregate: "max"},
+		{name: "load min unsupported", metric: "load_5", hostAggregate: "min", expectError: true},
+		{name: "service metric", metric: "threads_connected", service: "mysql"},
+		{name: "service metric without service", metric: "threads_active", expectError: true},
+		{name: "op rates", metric: "op_rates", service: "mysql", operation: "select"},
+		{name: "op rates without operation", metric: "op_rates", service: "mysql", expectError: true},
+		{name: "op rates fetch unsupported", metric: "op_rates", service: "mysql", operation: "fetch", expectError: true},
+		{name: "schema metric", metric: "schema_latency", service: "mysql", schema: "app", operation: "fetch"},
+		{name: "schema metric without schema", metric: "schema_throughput", service: "mysql", operation: "insert", expectError: true},
+		{name: "schema metric select unsupported", metric: "schema_throughput", service: "mysql", schema: "app", operation: "select", expectError: true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateDatabaseMetricsArgs(tc.metric, tc.service, tc.schema, tc.operation, tc.hostAggregate)
+			if tc.expectError && err == nil {
+				t.Error("expected an error")
+			}
+			if !tc.expectError && err != nil {
+				t.Errorf("unexpected error: %s", err)
+			}
+		})
+	}
+}
+
+func TestDatabaseMetricsUnsupportedEngine(t *testing.T) {
+	clusterID := "3f2549b8-9257-4e2f-a04c-e23547b2d685"
+
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	mux.HandleFunc(fmt.Sprintf("/v2/databases/%s", clusterID), func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"database": {"id": %q, "engine": "pg"}}`, clusterID)
+	})
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	cfg := &config.Config{
+		Token:       "test-token",
+		APIEndpoint: server.URL,
+	}
+	client, err := cfg.Client()
+	if err != nil {
+		t.Fatalf("error building client: %s", err)
+	}
+
+	d := schema.TestResourceDataRaw(t, DataSourceDigitalOceanDatabaseMetrics().Schema, map[string]interface{}{
+		"cluster_id": clusterID,
+		"metric":     "cpu_usage",
+	})
+
+	diags := dataSourceDigitalOceanDatabaseMetricsRead(context.Background(), d, client)
+	if !diags.HasError() {
+		t.Fatal("expected an error for a pg cluster")
+	}
+	assert.Contains(t, diags[0].Summary, "uses the pg engine, but metrics are only supported for mysql clusters")
+}
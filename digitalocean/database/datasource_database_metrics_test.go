@@ -0,0 +1,48 @@
+package database_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceDigitalOceanDatabaseMetrics_MySQL(t *testing.T) {
+	var database godo.Database
+	databaseName := acceptance.RandomTestName()
+	databaseConfig := fmt.Sprintf(testAccCheckDigitalOceanDatabaseClusterMySQL, databaseName, "8")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanDatabaseClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: databaseConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDigitalOceanDatabaseClusterExists("digitalocean_database_cluster.foobar", &database),
+				),
+			},
+			{
+				Config: databaseConfig + testAccCheckDigitalOceanDatasourceDatabaseMetricsConfigCPU,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.digitalocean_database_metrics.cpu", "metric", "cpu_usage"),
+					resource.TestCheckResourceAttrSet("data.digitalocean_database_metrics.cpu", "sample_count"),
+					resource.TestCheckResourceAttrSet("data.digitalocean_database_metrics.cpu", "series.#"),
+				),
+			},
+		},
+	})
+}
+
+const (
+	testAccCheckDigitalOceanDatasourceDatabaseMetricsConfigCPU = `
+data "digitalocean_database_metrics" "cpu" {
+  cluster_id  = digitalocean_database_cluster.foobar.id
+  metric      = "cpu_usage"
+  window      = "30m"
+  aggregation = "max"
+}`
+)
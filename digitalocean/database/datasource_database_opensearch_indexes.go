@@ -0,0 +1,96 @@
+package database
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/digitalocean/terraform-provider-digitalocean/internal/datalist"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func DataSourceDigitalOceanDatabaseOpensearchIndexes() *schema.Resource {
+	dataListConfig := &datalist.ResourceConfig{
+		RecordSchema: map[string]*schema.Schema{
+			"index_name": {
+				Type:        schema.TypeString,
+				Description: "name of the index",
+			},
+			"number_of_shards": {
+				Type:        schema.TypeInt,
+				Description: "number of primary shards of the index",
+			},
+			"number_of_replicas": {
+				Type:        schema.TypeInt,
+				Description: "number of replicas of each primary shard",
+			},
+			"size": {
+				Type:        schema.TypeInt,
+				Description: "size of the index in bytes",
+			},
+			"docs": {
+				Type:        schema.TypeInt,
+				Description: "number of documents in the index",
+			},
+			"health": {
+				Type:        schema.TypeString,
+				Description: "health of the index",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Description: "status of the index",
+			},
+			"create_time": {
+				Type:        schema.TypeString,
+				Description: "time the index was created",
+			},
+		},
+		ResultAttributeName: "indexes",
+		FlattenRecord:       flattenDigitalOceanDatabaseOpensearchIndex,
+		GetRecords:          getDigitalOceanDatabaseOpensearchIndexes,
+		ExtraQuerySchema: map[string]*schema.Schema{
+			"cluster_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+		},
+	}
+
+	return datalist.NewResource(dataListConfig)
+}
+
+func getDigitalOceanDatabaseOpensearchIndexes(meta interface{}, extra map[string]interface{}) ([]interface{}, error) {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	clusterID := extra["cluster_id"].(string)
+
+	indexes, _, err := client.Databases.ListIndexes(context.Background(), clusterID, nil)
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving indexes for database cluster (%s): %s", clusterID, err)
+	}
+
+	indexList := make([]interface{}, 0, len(indexes))
+	for _, index := range indexes {
+		indexList = append(indexList, index)
+	}
+
+	return indexList, nil
+}
+
+func flattenDigitalOceanDatabaseOpensearchIndex(rawIndex, meta interface{}, extra map[string]interface{}) (map[string]interface{}, error) {
+	index := rawIndex.(godo.DatabaseIndex)
+
+	return map[string]interface{}{
+		"index_name":         index.IndexName,
+		"number_of_shards":   int(index.NumberofShards),
+		"number_of_replicas": int(index.NumberofReplicas),
+		"size":               int(index.Size),
+		"docs":               int(index.Docs),
+		"health":             index.Health,
+		"status":             index.Status,
+		"create_time":        index.CreateTime,
+	}, nil
+}
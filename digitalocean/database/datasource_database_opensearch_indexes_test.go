@@ -0,0 +1,44 @@
+package database_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceDigitalOceanDatabaseOpensearchIndexes_Basic(t *testing.T) {
+	name := acceptance.RandomTestName()
+	dbConfig := fmt.Sprintf(testAccCheckDigitalOceanDatabaseClusterOpensearch, name, "2")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanDatabaseClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: dbConfig,
+			},
+			{
+				Config: dbConfig + testAccCheckDataSourceDigitalOceanDatabaseOpensearchIndexesConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.digitalocean_database_opensearch_indexes.foobar", "indexes.0.index_name"),
+					resource.TestCheckResourceAttrSet("data.digitalocean_database_opensearch_indexes.foobar", "indexes.0.health"),
+					resource.TestCheckResourceAttrSet("data.digitalocean_database_opensearch_indexes.foobar", "indexes.0.size"),
+					resource.TestCheckResourceAttrSet("data.digitalocean_database_opensearch_indexes.foobar", "indexes.0.docs"),
+				),
+			},
+		},
+	})
+}
+
+const testAccCheckDataSourceDigitalOceanDatabaseOpensearchIndexesConfig = `
+data "digitalocean_database_opensearch_indexes" "foobar" {
+  cluster_id = digitalocean_database_cluster.foobar.id
+
+  sort {
+    key       = "size"
+    direction = "desc"
+  }
+}`
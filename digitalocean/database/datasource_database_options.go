@@ -0,0 +1,116 @@
+package database
+
+import (
+	"context"
+	"sort"
+
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func DataSourceDigitalOceanDatabaseOptions() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceDigitalOceanDatabaseOptionsRead,
+		Schema: map[string]*schema.Schema{
+			"engine": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.NoZeroValues,
+				Description:  "Only return the options of this engine",
+			},
+			"engines": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"engine": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"versions": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"regions": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"layouts": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"num_nodes": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"sizes": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceDigitalOceanDatabaseOptionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	options, _, err := client.Databases.ListOptions(ctx)
+	if err != nil {
+		return diag.Errorf("Error retrieving database options: %s", err)
+	}
+
+	catalog := databaseEngineOptions(options)
+	filter := d.Get("engine").(string)
+	if _, ok := catalog[filter]; filter != "" && !ok {
+		return diag.Errorf("Error retrieving database options: engine %s not found", filter)
+	}
+
+	slugs := make([]string, 0, len(catalog))
+	for slug := range catalog {
+		if filter == "" || slug == filter {
+			slugs = append(slugs, slug)
+		}
+	}
+	sort.Strings(slugs)
+
+	engines := make([]map[string]interface{}, 0, len(slugs))
+	for _, slug := range slugs {
+		engineOptions := catalog[slug]
+
+		layouts := make([]map[string]interface{}, 0, len(engineOptions.Layouts))
+		for _, layout := range engineOptions.Layouts {
+			layouts = append(layouts, map[string]interface{}{
+				"num_nodes": layout.NodeNum,
+				"sizes":     layout.Sizes,
+			})
+		}
+
+		engines = append(engines, map[string]interface{}{
+			"engine":   slug,
+			"versions": engineOptions.Versions,
+			"regions":  engineOptions.Regions,
+			"layouts":  layouts,
+		})
+	}
+
+	d.SetId(id.UniqueId())
+
+	if err := d.Set("engines", engines); err != nil {
+		return diag.Errorf("Error setting engines: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,33 @@
+package database_test
+
+import (
+	"testing"
+
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceDigitalOceanDatabaseOptions_Basic(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDataSourceDigitalOceanDatabaseOptionsConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.digitalocean_database_options.foobar", "engines.#", "1"),
+					resource.TestCheckResourceAttr("data.digitalocean_database_options.foobar", "engines.0.engine", "pg"),
+					resource.TestCheckResourceAttrSet("data.digitalocean_database_options.foobar", "engines.0.versions.0"),
+					resource.TestCheckResourceAttrSet("data.digitalocean_database_options.foobar", "engines.0.regions.0"),
+					resource.TestCheckResourceAttrSet("data.digitalocean_database_options.foobar", "engines.0.layouts.0.num_nodes"),
+					resource.TestCheckResourceAttrSet("data.digitalocean_database_options.foobar", "engines.0.layouts.0.sizes.0"),
+				),
+			},
+		},
+	})
+}
+
+const testAccCheckDataSourceDigitalOceanDatabaseOptionsConfig = `
+data "digitalocean_database_options" "foobar" {
+  engine = "pg"
+}`
@@ -27,6 +27,8 @@ func TestAccDigitalOceanDatabaseUser_importBasic(t *testing.T) {
 				ResourceName:      resourceName,
 				ImportState:       true,
 				ImportStateVerify: true,
+				// The rotation period of an imported user starts when it is imported.
+				ImportStateVerifyIgnore: []string{"password_rotated_at"},
 				// Requires passing both the cluster ID and user name
 				ImportStateIdFunc: testAccDatabaseUserImportID(resourceName),
 			},
@@ -15,6 +15,7 @@ import (
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/tag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -131,6 +132,12 @@ func ResourceDigitalOceanDatabaseCluster() *schema.Resource {
 				},
 			},
 
+			// changing the trigger installs pending maintenance updates
+			"install_update_trigger": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
 			"eviction_policy": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -143,9 +150,10 @@ func ResourceDigitalOceanDatabaseCluster() *schema.Resource {
 			},
 
 			"private_network_uuid": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ForceNew:     true,
+				Type:     schema.TypeString,
+				Optional: true,
+				// Changing the VPC forces a new cluster unless the cluster is
+				// migrated to a new region along with it, see CustomizeDiff.
 				Computed:     true,
 				ValidateFunc: validation.NoZeroValues,
 			},
@@ -300,11 +308,17 @@ func ResourceDigitalOceanDatabaseCluster() *schema.Resource {
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		CustomizeDiff: customdiff.All(
 			transitionVersionToRequired(),
 			validateExclusiveAttributes(),
+			validateBackupRestore(),
+			validateDatabaseClusterOptions,
+			customdiff.ForceNewIf("private_network_uuid", func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) bool {
+				return !d.HasChange("region")
+			}),
 		),
 	}
 }
@@ -346,6 +360,107 @@ func validateExclusiveAttributes() schema.CustomizeDiffFunc {
 	})
 }
 
+// validateBackupRestore checks that the source cluster of a new cluster has a
+// backup to restore from, and that the requested point in time falls within its
+// retention window.
+func validateBackupRestore() schema.CustomizeDiffFunc {
+	return schema.CustomizeDiffFunc(func(ctx context.Context, diff *schema.ResourceDiff, v interface{}) error {
+		if diff.Id() != "" || !diff.NewValueKnown("backup_restore") {
+			return nil
+		}
+
+		backupRestore := diff.Get("backup_restore").([]interface{})
+		if len(backupRestore) == 0 || backupRestore[0] == nil {
+			return nil
+		}
+
+		restore := expandBackupRestore(backupRestore)
+		if restore.DatabaseName == "" {
+			return nil
+		}
+
+		client := v.(*config.CombinedConfig).GodoClient()
+
+		source, err := findDatabaseClusterByName(ctx, client, restore.DatabaseName)
+		if err != nil {
+			return err
+		}
+
+		backups, err := listDatabaseBackups(ctx, client, source.ID)
+		if err != nil {
+			return err
+		}
+
+		return checkBackupRestoreTimestamp(restore, backups, time.Now())
+	})
+}
+
+func checkBackupRestoreTimestamp(restore *godo.DatabaseBackupRestore, backups []godo.DatabaseBackup, now time.Time) error {
+	if len(backups) == 0 {
+		return fmt.Errorf("database cluster %s has no backups to restore from", restore.DatabaseName)
+	}
+
+	if restore.BackupCreatedAt == "" {
+		return nil
+	}
+
+	createdAt, err := time.Parse(time.RFC3339, restore.BackupCreatedAt)
+	if err != nil {
+		return fmt.Errorf("backup_created_at must be a timestamp in RFC3339 format: %s", err)
+	}
+
+	oldest := backups[0].CreatedAt
+	for _, backup := range backups[1:] {
+		if backup.CreatedAt.Before(oldest) {
+			oldest = backup.CreatedAt
+		}
+	}
+
+	if createdAt.Before(oldest) {
+		return fmt.Errorf("backup_created_at %s is before the oldest available backup of database cluster %s (%s)",
+			restore.BackupCreatedAt, restore.DatabaseName, oldest.UTC().Format(time.RFC3339))
+	}
+
+	if createdAt.After(now) {
+		return fmt.Errorf("backup_created_at %s is in the future", restore.BackupCreatedAt)
+	}
+
+	return nil
+}
+
+func findDatabaseClusterByName(ctx context.Context, client *godo.Client, name string) (*godo.Database, error) {
+	opts := &godo.ListOptions{
+		Page:    1,
+		PerPage: 200,
+	}
+
+	for {
+		databases, resp, err := client.Databases.List(ctx, opts)
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving DatabaseClusters: %s", err)
+		}
+
+		for _, db := range databases {
+			if db.Name == name {
+				return &db, nil
+			}
+		}
+
+		if resp.Links == nil || resp.Links.IsLastPage() {
+			break
+		}
+
+		page, err := resp.Links.CurrentPage()
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving DatabaseClusters: %s", err)
+		}
+
+		opts.Page = page + 1
+	}
+
+	return nil, fmt.Errorf("Unable to find database cluster %s to restore from", name)
+}
+
 func resourceDigitalOceanDatabaseClusterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*config.CombinedConfig).GodoClient()
 
@@ -472,8 +587,14 @@ func resourceDigitalOceanDatabaseClusterUpdate(ctx context.Context, d *schema.Re
 	}
 
 	if d.HasChange("region") {
+		region := d.Get("region").(string)
 		opts := &godo.DatabaseMigrateRequest{
-			Region: d.Get("region").(string),
+			Region: region,
+		}
+
+		// A VPC belongs to a single region, so it may move along with the cluster.
+		if d.HasChange("private_network_uuid") {
+			opts.PrivateNetworkUUID = d.Get("private_network_uuid").(string)
 		}
 
 		resp, err := client.Databases.Migrate(context.Background(), d.Id(), opts)
@@ -488,7 +609,7 @@ func resourceDigitalOceanDatabaseClusterUpdate(ctx context.Context, d *schema.Re
 			return diag.Errorf("Error migrating database cluster: %s", err)
 		}
 
-		_, err = waitForDatabaseCluster(client, d, "online")
+		err = waitForDatabaseClusterMigration(ctx, client, d.Id(), region, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return diag.Errorf("Error migrating database cluster: %s", err)
 		}
@@ -510,6 +631,28 @@ func resourceDigitalOceanDatabaseClusterUpdate(ctx context.Context, d *schema.Re
 		}
 	}
 
+	if d.HasChange("install_update_trigger") && d.Get("install_update_trigger").(string) != "" {
+		resp, err := client.Databases.InstallUpdate(context.Background(), d.Id())
+		if err != nil {
+			// If the database is somehow already destroyed, mark as
+			// successfully gone
+			if resp != nil && resp.StatusCode == 404 {
+				d.SetId("")
+				return nil
+			}
+
+			// Keep the previous trigger in state so the install is retried.
+			d.Partial(true)
+			return diag.Errorf("Error installing updates for database cluster: %s", err)
+		}
+
+		err = waitForDatabaseClusterUpdateInstall(ctx, client, d.Id(), d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			d.Partial(true)
+			return diag.Errorf("Error installing updates for database cluster: %s", err)
+		}
+	}
+
 	if d.HasChange("eviction_policy") {
 		if policy, ok := d.GetOk("eviction_policy"); ok {
 			_, err := client.Databases.SetEvictionPolicy(context.Background(), d.Id(), policy.(string))
@@ -694,6 +837,69 @@ func waitForDatabaseCluster(client *godo.Client, d *schema.ResourceData, status
 	return nil, fmt.Errorf("Timeout waiting to database cluster to become %s", status)
 }
 
+// waitForDatabaseClusterMigration waits for the cluster to be online in the new
+// region. The cluster may still report being online in the old region shortly
+// after the migration is requested.
+func waitForDatabaseClusterMigration(ctx context.Context, client *godo.Client, id, region string, timeout time.Duration) error {
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{"migrating"},
+		Target:     []string{"online"},
+		Refresh:    databaseClusterMigrationRefreshFunc(ctx, client, id, region),
+		Delay:      15 * time.Second,
+		MinTimeout: 15 * time.Second,
+		Timeout:    timeout,
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+	return err
+}
+
+func databaseClusterMigrationRefreshFunc(ctx context.Context, client *godo.Client, id, region string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		database, _, err := client.Databases.Get(ctx, id)
+		if err != nil {
+			return nil, "", fmt.Errorf("Error trying to read database cluster state: %s", err)
+		}
+
+		if database.Status != "online" || !strings.EqualFold(database.RegionSlug, region) {
+			return database, "migrating", nil
+		}
+
+		return database, "online", nil
+	}
+}
+
+// waitForDatabaseClusterUpdateInstall waits for the cluster to be online with
+// no maintenance updates pending.
+func waitForDatabaseClusterUpdateInstall(ctx context.Context, client *godo.Client, id string, timeout time.Duration) error {
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{"updating"},
+		Target:     []string{"online"},
+		Refresh:    databaseClusterUpdateInstallRefreshFunc(ctx, client, id),
+		Delay:      15 * time.Second,
+		MinTimeout: 15 * time.Second,
+		Timeout:    timeout,
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+	return err
+}
+
+func databaseClusterUpdateInstallRefreshFunc(ctx context.Context, client *godo.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		database, _, err := client.Databases.Get(ctx, id)
+		if err != nil {
+			return nil, "", fmt.Errorf("Error trying to read database cluster state: %s", err)
+		}
+
+		if database.Status != "online" || (database.MaintenanceWindow != nil && database.MaintenanceWindow.Pending) {
+			return database, "updating", nil
+		}
+
+		return database, "online", nil
+	}
+}
+
 func expandMaintWindowOpts(config []interface{}) *godo.DatabaseUpdateMaintenanceRequest {
 	maintWindowOpts := &godo.DatabaseUpdateMaintenanceRequest{}
 	configMap := config[0].(map[string]interface{})
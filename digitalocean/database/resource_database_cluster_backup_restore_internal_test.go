@@ -0,0 +1,49 @@
+package database
+
+import (
+	"testing"
+	"time"
+
+	"github.com/digitalocean/godo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckBackupRestoreTimestamp(t *testing.T) {
+	now := time.Date(2024, 6, 10, 12, 0, 0, 0, time.UTC)
+	backups := []godo.DatabaseBackup{
+		{CreatedAt: time.Date(2024, 6, 9, 0, 0, 0, 0, time.UTC)},
+		{CreatedAt: time.Date(2024, 6, 3, 0, 0, 0, 0, time.UTC)},
+		{CreatedAt: time.Date(2024, 6, 6, 0, 0, 0, 0, time.UTC)},
+	}
+
+	cases := []struct {
+		name        string
+		createdAt   string
+		backups     []godo.DatabaseBackup
+		expectError string
+	}{
+		{name: "latest backup", backups: backups},
+		{name: "within window", createdAt: "2024-06-04T15:30:00Z", backups: backups},
+		{name: "oldest backup", createdAt: "2024-06-03T00:00:00Z", backups: backups},
+		{name: "before window", createdAt: "2024-06-02T23:59:59Z", backups: backups, expectError: "backup_created_at 2024-06-02T23:59:59Z is before the oldest available backup of database cluster source (2024-06-03T00:00:00Z)"},
+		{name: "future", createdAt: "2024-06-11T00:00:00Z", backups: backups, expectError: "backup_created_at 2024-06-11T00:00:00Z is in the future"},
+		{name: "invalid", createdAt: "yesterday", backups: backups, expectError: `backup_created_at must be a timestamp in RFC3339 format: parsing time "yesterday" as "2006-01-02T15:04:05Z07:00": cannot parse "yesterday" as "2006"`},
+		{name: "no backups", expectError: "database cluster source has no backups to restore from"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			restore := &godo.DatabaseBackupRestore{
+				DatabaseName:    "source",
+				BackupCreatedAt: tc.createdAt,
+			}
+
+			err := checkBackupRestoreTimestamp(restore, tc.backups, now)
+			if tc.expectError == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tc.expectError)
+			}
+		})
+	}
+}
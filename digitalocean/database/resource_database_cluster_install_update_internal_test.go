@@ -0,0 +1,57 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDatabaseClusterUpdateInstallRefreshFunc(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	var (
+		status  string
+		pending bool
+	)
+	mux.HandleFunc("/v2/databases/cluster-1", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"database": {"id": "cluster-1", "status": "%s", "maintenance_window": {"day": "sunday", "hour": "02:00:00", "pending": %t}}}`, status, pending)
+	})
+
+	cfg := &config.Config{
+		Token:       "test-token",
+		APIEndpoint: server.URL,
+	}
+	combined, err := cfg.Client()
+	if err != nil {
+		t.Fatalf("error building client: %s", err)
+	}
+	refresh := databaseClusterUpdateInstallRefreshFunc(context.Background(), combined.GodoClient(), "cluster-1")
+
+	states := []struct {
+		status   string
+		pending  bool
+		expected string
+	}{
+		// The update has not started yet.
+		{"online", true, "updating"},
+		{"maintenance", true, "updating"},
+		{"maintenance", false, "updating"},
+		{"online", false, "online"},
+	}
+	for _, s := range states {
+		status, pending = s.status, s.pending
+
+		_, state, err := refresh()
+		if err != nil {
+			t.Fatalf("refresh returned error: %s", err)
+		}
+		assert.Equal(t, s.expected, state, "status: %s, pending: %t", s.status, s.pending)
+	}
+}
@@ -0,0 +1,54 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDatabaseClusterMigrationRefreshFunc(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	var status, region string
+	mux.HandleFunc("/v2/databases/cluster-1", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"database": {"id": "cluster-1", "status": "%s", "region": "%s"}}`, status, region)
+	})
+
+	cfg := &config.Config{
+		Token:       "test-token",
+		APIEndpoint: server.URL,
+	}
+	combined, err := cfg.Client()
+	if err != nil {
+		t.Fatalf("error building client: %s", err)
+	}
+	refresh := databaseClusterMigrationRefreshFunc(context.Background(), combined.GodoClient(), "cluster-1", "ams3")
+
+	states := []struct {
+		status   string
+		region   string
+		expected string
+	}{
+		// The migration has not started yet.
+		{"online", "nyc1", "migrating"},
+		{"migrating", "nyc1", "migrating"},
+		{"migrating", "ams3", "migrating"},
+		{"online", "ams3", "online"},
+	}
+	for _, s := range states {
+		status, region = s.status, s.region
+
+		_, state, err := refresh()
+		if err != nil {
+			t.Fatalf("refresh returned error: %s", err)
+		}
+		assert.Equal(t, s.expected, state, "status: %s, region: %s", s.status, s.region)
+	}
+}
@@ -212,6 +212,87 @@ func TestAccDigitalOceanDatabaseCluster_WithMigration(t *testing.T) {
 	})
 }
 
+func TestAccDigitalOceanDatabaseCluster_WithMigrationAndVPC(t *testing.T) {
+	var database godo.Database
+	var originalID string
+	vpcName := acceptance.RandomTestName()
+	databaseName := acceptance.RandomTestName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanDatabaseClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccCheckDigitalOceanDatabaseClusterConfigWithVPC, vpcName, databaseName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDigitalOceanDatabaseClusterExists("digitalocean_database_cluster.foobar", &database),
+					func(s *terraform.State) error {
+						originalID = database.ID
+						return nil
+					},
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccCheckDigitalOceanDatabaseClusterConfigWithMigrationAndVPC, vpcName, vpcName, databaseName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDigitalOceanDatabaseClusterExists("digitalocean_database_cluster.foobar", &database),
+					resource.TestCheckResourceAttr(
+						"digitalocean_database_cluster.foobar", "region", "lon1"),
+					resource.TestCheckResourceAttrPair(
+						"digitalocean_database_cluster.foobar", "private_network_uuid",
+						"digitalocean_vpc.lon1", "id"),
+					func(s *terraform.State) error {
+						if database.ID != originalID {
+							return fmt.Errorf("database cluster was replaced instead of migrated")
+						}
+						if database.RegionSlug != "lon1" {
+							return fmt.Errorf("database cluster is in %s, expected lon1", database.RegionSlug)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestAccDigitalOceanDatabaseCluster_InstallUpdateTrigger(t *testing.T) {
+	var database godo.Database
+	databaseName := acceptance.RandomTestName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanDatabaseClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccCheckDigitalOceanDatabaseClusterConfigWithInstallUpdateTrigger, databaseName, "initial"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDigitalOceanDatabaseClusterExists("digitalocean_database_cluster.foobar", &database),
+				),
+			},
+			{
+				// Changing the trigger installs any pending updates.
+				Config: fmt.Sprintf(testAccCheckDigitalOceanDatabaseClusterConfigWithInstallUpdateTrigger, databaseName, "patch-window"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDigitalOceanDatabaseClusterExists("digitalocean_database_cluster.foobar", &database),
+					resource.TestCheckResourceAttr(
+						"digitalocean_database_cluster.foobar", "install_update_trigger", "patch-window"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_database_cluster.foobar", "status", "online"),
+					func(s *terraform.State) error {
+						if database.MaintenanceWindow != nil && database.MaintenanceWindow.Pending {
+							return fmt.Errorf("database cluster still has pending updates")
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
 func TestAccDigitalOceanDatabaseCluster_WithMaintWindow(t *testing.T) {
 	var database godo.Database
 	databaseName := acceptance.RandomTestName()
@@ -703,6 +784,28 @@ func TestAccDigitalOceanDatabaseCluster_nonDefaultProject(t *testing.T) {
 	})
 }
 
+func TestAccDigitalOceanDatabaseCluster_UnsupportedOptions(t *testing.T) {
+	name := acceptance.RandomTestName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanDatabaseClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      fmt.Sprintf(testAccCheckDigitalOceanDatabaseClusterUnsupportedOptionsConfig, name, "15", 7),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`node_count 7 is not supported for engine pg`),
+			},
+			{
+				Config:      fmt.Sprintf(testAccCheckDigitalOceanDatabaseClusterUnsupportedOptionsConfig, name, "9", 1),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`version 9 is not supported for engine pg`),
+			},
+		},
+	})
+}
+
 func testAccCheckDigitalOceanDatabaseClusterDestroy(s *terraform.State) error {
 	client := acceptance.TestAccProvider.Meta().(*config.CombinedConfig).GodoClient()
 
@@ -1212,6 +1315,39 @@ resource "digitalocean_database_cluster" "foobar" {
   private_network_uuid = digitalocean_vpc.foobar.id
 }`
 
+const testAccCheckDigitalOceanDatabaseClusterConfigWithMigrationAndVPC = `
+resource "digitalocean_vpc" "foobar" {
+  name   = "%s"
+  region = "nyc1"
+}
+
+resource "digitalocean_vpc" "lon1" {
+  name   = "%s-lon1"
+  region = "lon1"
+}
+
+resource "digitalocean_database_cluster" "foobar" {
+  name                 = "%s"
+  engine               = "pg"
+  version              = "15"
+  size                 = "db-s-1vcpu-2gb"
+  region               = "lon1"
+  node_count           = 1
+  tags                 = ["production"]
+  private_network_uuid = digitalocean_vpc.lon1.id
+}`
+
+const testAccCheckDigitalOceanDatabaseClusterConfigWithInstallUpdateTrigger = `
+resource "digitalocean_database_cluster" "foobar" {
+  name                   = "%s"
+  engine                 = "pg"
+  version                = "15"
+  size                   = "db-s-1vcpu-2gb"
+  region                 = "nyc1"
+  node_count             = 1
+  install_update_trigger = "%s"
+}`
+
 const testAccCheckDigitalOceanDatabaseClusterConfigMongoDB = `
 resource "digitalocean_database_cluster" "foobar" {
   name       = "%s"
@@ -1246,3 +1382,13 @@ resource "digitalocean_database_cluster" "foobar" {
   node_count = 1
   project_id = digitalocean_project.foobar.id
 }`
+
+const testAccCheckDigitalOceanDatabaseClusterUnsupportedOptionsConfig = `
+resource "digitalocean_database_cluster" "foobar" {
+  name       = "%s"
+  engine     = "pg"
+  version    = "%s"
+  size       = "db-s-1vcpu-1gb"
+  region     = "nyc1"
+  node_count = %d
+}`
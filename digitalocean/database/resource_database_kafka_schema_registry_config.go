@@ -0,0 +1,130 @@
+package database
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// kafkaSchemaRegistryCompatibilityLevels are the compatibility levels accepted
+// by the schema registry, both globally and per subject.
+var kafkaSchemaRegistryCompatibilityLevels = []string{
+	"NONE",
+	"BACKWARD",
+	"BACKWARD_TRANSITIVE",
+	"FORWARD",
+	"FORWARD_TRANSITIVE",
+	"FULL",
+	"FULL_TRANSITIVE",
+}
+
+func ResourceDigitalOceanDatabaseKafkaSchemaRegistryConfig() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDigitalOceanDatabaseKafkaSchemaRegistryConfigCreate,
+		ReadContext:   resourceDigitalOceanDatabaseKafkaSchemaRegistryConfigRead,
+		UpdateContext: resourceDigitalOceanDatabaseKafkaSchemaRegistryConfigUpdate,
+		DeleteContext: resourceDigitalOceanDatabaseKafkaSchemaRegistryConfigDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceDigitalOceanDatabaseKafkaSchemaRegistryConfigImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"cluster_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"compatibility_level": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(kafkaSchemaRegistryCompatibilityLevels, false),
+			},
+		},
+	}
+}
+
+func resourceDigitalOceanDatabaseKafkaSchemaRegistryConfigCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GodoClient()
+	clusterID := d.Get("cluster_id").(string)
+
+	if err := updateKafkaSchemaRegistryConfig(ctx, d, client); err != nil {
+		return diag.Errorf("Error updating Kafka schema registry configuration: %s", err)
+	}
+
+	d.SetId(makeDatabaseKafkaSchemaRegistryConfigID(clusterID))
+
+	return resourceDigitalOceanDatabaseKafkaSchemaRegistryConfigRead(ctx, d, meta)
+}
+
+func resourceDigitalOceanDatabaseKafkaSchemaRegistryConfigUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	if err := updateKafkaSchemaRegistryConfig(ctx, d, client); err != nil {
+		return diag.Errorf("Error updating Kafka schema registry configuration: %s", err)
+	}
+
+	return resourceDigitalOceanDatabaseKafkaSchemaRegistryConfigRead(ctx, d, meta)
+}
+
+func updateKafkaSchemaRegistryConfig(ctx context.Context, d *schema.ResourceData, client *godo.Client) error {
+	clusterID := d.Get("cluster_id").(string)
+
+	opts := &godo.DatabaseKafkaSchemaRegistryConfig{
+		CompatibilityLevel: d.Get("compatibility_level").(string),
+	}
+
+	_, _, err := client.Databases.UpdateKafkaSchemaRegistryConfig(ctx, clusterID, opts)
+
+	return err
+}
+
+func resourceDigitalOceanDatabaseKafkaSchemaRegistryConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GodoClient()
+	clusterID := d.Get("cluster_id").(string)
+
+	config, resp, err := client.Databases.GetKafkaSchemaRegistryConfig(ctx, clusterID)
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+
+		return diag.Errorf("Error retrieving Kafka schema registry configuration: %s", err)
+	}
+
+	d.Set("compatibility_level", config.CompatibilityLevel)
+
+	return nil
+}
+
+func resourceDigitalOceanDatabaseKafkaSchemaRegistryConfigDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId("")
+
+	warn := []diag.Diagnostic{
+		{
+			Severity: diag.Warning,
+			Summary:  "digitalocean_database_kafka_schema_registry_config removed from state",
+			Detail:   "Database configurations are only removed from state when destroyed. The remote configuration is not unset.",
+		},
+	}
+
+	return warn
+}
+
+func resourceDigitalOceanDatabaseKafkaSchemaRegistryConfigImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	clusterID := d.Id()
+
+	d.SetId(makeDatabaseKafkaSchemaRegistryConfigID(clusterID))
+	d.Set("cluster_id", clusterID)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func makeDatabaseKafkaSchemaRegistryConfigID(clusterID string) string {
+	return fmt.Sprintf("%s/schema-registry/config", clusterID)
+}
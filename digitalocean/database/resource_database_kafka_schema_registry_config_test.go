@@ -0,0 +1,95 @@
+package database_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccDigitalOceanDatabaseKafkaSchemaRegistryConfig_Basic(t *testing.T) {
+	databaseClusterName := acceptance.RandomTestName()
+	subjectName := acceptance.RandomTestName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanDatabaseClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccCheckDigitalOceanDatabaseKafkaSchemaRegistryConfigConfig, databaseClusterName, subjectName, "BACKWARD", "FULL"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("digitalocean_database_kafka_schema_registry_config.foobar", "compatibility_level", "BACKWARD"),
+					resource.TestCheckResourceAttr("digitalocean_database_kafka_schema_registry_subject_config.foobar", "subject_name", subjectName),
+					resource.TestCheckResourceAttr("digitalocean_database_kafka_schema_registry_subject_config.foobar", "compatibility_level", "FULL"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccCheckDigitalOceanDatabaseKafkaSchemaRegistryConfigConfig, databaseClusterName, subjectName, "FORWARD_TRANSITIVE", "FULL_TRANSITIVE"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("digitalocean_database_kafka_schema_registry_config.foobar", "compatibility_level", "FORWARD_TRANSITIVE"),
+					resource.TestCheckResourceAttr("digitalocean_database_kafka_schema_registry_subject_config.foobar", "compatibility_level", "FULL_TRANSITIVE"),
+				),
+			},
+			{
+				ResourceName:      "digitalocean_database_kafka_schema_registry_config.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					return s.RootModule().Resources["digitalocean_database_cluster.kafka"].Primary.ID, nil
+				},
+			},
+			{
+				ResourceName:      "digitalocean_database_kafka_schema_registry_subject_config.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					clusterID := s.RootModule().Resources["digitalocean_database_cluster.kafka"].Primary.ID
+					return fmt.Sprintf("%s,%s", clusterID, subjectName), nil
+				},
+			},
+		},
+	})
+}
+
+const testAccCheckDigitalOceanDatabaseKafkaSchemaRegistryConfigConfig = `
+resource "digitalocean_database_cluster" "kafka" {
+  name       = "%s"
+  engine     = "kafka"
+  version    = "3.5"
+  size       = "db-s-1vcpu-1gb"
+  region     = "nyc1"
+  node_count = 3
+}
+
+resource "digitalocean_database_kafka_schema_registry" "foobar" {
+  cluster_id   = digitalocean_database_cluster.kafka.id
+  subject_name = "%s"
+  schema_type  = "avro"
+  schema       = <<EOF
+{
+  "type": "record",
+  "name": "example",
+  "fields": [
+    {
+      "name": "id",
+      "type": "int"
+    }
+  ]
+}
+EOF
+}
+
+resource "digitalocean_database_kafka_schema_registry_config" "foobar" {
+  cluster_id          = digitalocean_database_cluster.kafka.id
+  compatibility_level = "%s"
+}
+
+resource "digitalocean_database_kafka_schema_registry_subject_config" "foobar" {
+  cluster_id          = digitalocean_database_cluster.kafka.id
+  subject_name        = digitalocean_database_kafka_schema_registry.foobar.subject_name
+  compatibility_level = "%s"
+}
+`
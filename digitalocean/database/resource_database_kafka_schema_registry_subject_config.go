@@ -0,0 +1,134 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func ResourceDigitalOceanDatabaseKafkaSchemaRegistrySubjectConfig() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDigitalOceanDatabaseKafkaSchemaRegistrySubjectConfigCreate,
+		ReadContext:   resourceDigitalOceanDatabaseKafkaSchemaRegistrySubjectConfigRead,
+		UpdateContext: resourceDigitalOceanDatabaseKafkaSchemaRegistrySubjectConfigUpdate,
+		DeleteContext: resourceDigitalOceanDatabaseKafkaSchemaRegistrySubjectConfigDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceDigitalOceanDatabaseKafkaSchemaRegistrySubjectConfigImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"cluster_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"subject_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"compatibility_level": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(kafkaSchemaRegistryCompatibilityLevels, false),
+			},
+		},
+	}
+}
+
+func resourceDigitalOceanDatabaseKafkaSchemaRegistrySubjectConfigCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GodoClient()
+	clusterID := d.Get("cluster_id").(string)
+	subjectName := d.Get("subject_name").(string)
+
+	if err := updateKafkaSchemaRegistrySubjectConfig(ctx, d, client); err != nil {
+		return diag.Errorf("Error updating Kafka schema registry subject configuration: %s", err)
+	}
+
+	d.SetId(makeDatabaseKafkaSchemaRegistrySubjectConfigID(clusterID, subjectName))
+
+	return resourceDigitalOceanDatabaseKafkaSchemaRegistrySubjectConfigRead(ctx, d, meta)
+}
+
+func resourceDigitalOceanDatabaseKafkaSchemaRegistrySubjectConfigUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	if err := updateKafkaSchemaRegistrySubjectConfig(ctx, d, client); err != nil {
+		return diag.Errorf("Error updating Kafka schema registry subject configuration: %s", err)
+	}
+
+	return resourceDigitalOceanDatabaseKafkaSchemaRegistrySubjectConfigRead(ctx, d, meta)
+}
+
+func updateKafkaSchemaRegistrySubjectConfig(ctx context.Context, d *schema.ResourceData, client *godo.Client) error {
+	clusterID := d.Get("cluster_id").(string)
+	subjectName := d.Get("subject_name").(string)
+
+	opts := &godo.DatabaseKafkaSchemaRegistryConfig{
+		CompatibilityLevel: d.Get("compatibility_level").(string),
+	}
+
+	_, _, err := client.Databases.UpdateKafkaSchemaRegistrySubjectConfig(ctx, clusterID, subjectName, opts)
+
+	return err
+}
+
+func resourceDigitalOceanDatabaseKafkaSchemaRegistrySubjectConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GodoClient()
+	clusterID := d.Get("cluster_id").(string)
+	subjectName := d.Get("subject_name").(string)
+
+	config, resp, err := client.Databases.GetKafkaSchemaRegistrySubjectConfig(ctx, clusterID, subjectName)
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+
+		return diag.Errorf("Error retrieving Kafka schema registry subject configuration: %s", err)
+	}
+
+	d.Set("subject_name", config.SubjectName)
+	d.Set("compatibility_level", config.CompatibilityLevel)
+
+	return nil
+}
+
+func resourceDigitalOceanDatabaseKafkaSchemaRegistrySubjectConfigDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId("")
+
+	warn := []diag.Diagnostic{
+		{
+			Severity: diag.Warning,
+			Summary:  "digitalocean_database_kafka_schema_registry_subject_config removed from state",
+			Detail:   "Database configurations are only removed from state when destroyed. The remote configuration is not unset.",
+		},
+	}
+
+	return warn
+}
+
+func resourceDigitalOceanDatabaseKafkaSchemaRegistrySubjectConfigImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	s := strings.SplitN(d.Id(), ",", 2)
+	if len(s) != 2 || s[0] == "" || s[1] == "" {
+		return nil, errors.New("must use the ID of the source kafka cluster and the name of the subject joined with a comma (e.g. `id,name`)")
+	}
+
+	d.SetId(makeDatabaseKafkaSchemaRegistrySubjectConfigID(s[0], s[1]))
+	d.Set("cluster_id", s[0])
+	d.Set("subject_name", s[1])
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func makeDatabaseKafkaSchemaRegistrySubjectConfigID(clusterID string, subjectName string) string {
+	return fmt.Sprintf("%s/schema-registry/config/%s", clusterID, subjectName)
+}
@@ -0,0 +1,83 @@
+package database
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestDatabaseKafkaSchemaRegistrySubjectConfigCreate(t *testing.T) {
+	clusterID := "3f2549b8-9257-4e2f-a04c-e23547b2d685"
+	subjectName := "orders-value"
+
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	level := "BACKWARD"
+	mux.HandleFunc(fmt.Sprintf("/v2/databases/%s/schema-registry/config/%s", clusterID, subjectName), func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPut {
+			var req map[string]string
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				t.Fatalf("error decoding request: %s", err)
+			}
+			level = req["compatibility_level"]
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"subject_name": %q, "compatibility_level": %q}`, subjectName, level)
+	})
+
+	cfg := &config.Config{
+		Token:       "test-token",
+		APIEndpoint: server.URL,
+	}
+	client, err := cfg.Client()
+	if err != nil {
+		t.Fatalf("error building client: %s", err)
+	}
+
+	d := schema.TestResourceDataRaw(t, ResourceDigitalOceanDatabaseKafkaSchemaRegistrySubjectConfig().Schema, map[string]interface{}{
+		"cluster_id":          clusterID,
+		"subject_name":        subjectName,
+		"compatibility_level": "FULL_TRANSITIVE",
+	})
+
+	if diags := resourceDigitalOceanDatabaseKafkaSchemaRegistrySubjectConfigCreate(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("create returned error: %v", diags)
+	}
+
+	if want := makeDatabaseKafkaSchemaRegistrySubjectConfigID(clusterID, subjectName); d.Id() != want {
+		t.Errorf("id = %q, expected %q", d.Id(), want)
+	}
+	if got := d.Get("compatibility_level").(string); got != "FULL_TRANSITIVE" {
+		t.Errorf("compatibility_level = %q, expected %q", got, "FULL_TRANSITIVE")
+	}
+}
+
+func TestDatabaseKafkaSchemaRegistrySubjectConfigImport(t *testing.T) {
+	r := ResourceDigitalOceanDatabaseKafkaSchemaRegistrySubjectConfig()
+
+	d := r.TestResourceData()
+	d.SetId("3f2549b8-9257-4e2f-a04c-e23547b2d685,orders-value")
+	if _, err := resourceDigitalOceanDatabaseKafkaSchemaRegistrySubjectConfigImport(d, nil); err != nil {
+		t.Fatalf("import returned error: %s", err)
+	}
+	if got := d.Get("subject_name").(string); got != "orders-value" {
+		t.Errorf("subject_name = %q, expected %q", got, "orders-value")
+	}
+	if want := "3f2549b8-9257-4e2f-a04c-e23547b2d685/schema-registry/config/orders-value"; d.Id() != want {
+		t.Errorf("id = %q, expected %q", d.Id(), want)
+	}
+
+	d = r.TestResourceData()
+	d.SetId("3f2549b8-9257-4e2f-a04c-e23547b2d685")
+	if _, err := resourceDigitalOceanDatabaseKafkaSchemaRegistrySubjectConfigImport(d, nil); err == nil {
+		t.Error("expected an error importing without a subject name")
+	}
+}
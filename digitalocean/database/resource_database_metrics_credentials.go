@@ -0,0 +1,104 @@
+package database
+
+import (
+	"context"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/util"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func ResourceDigitalOceanDatabaseMetricsCredentials() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDigitalOceanDatabaseMetricsCredentialsCreate,
+		ReadContext:   resourceDigitalOceanDatabaseMetricsCredentialsRead,
+		UpdateContext: resourceDigitalOceanDatabaseMetricsCredentialsUpdate,
+		DeleteContext: resourceDigitalOceanDatabaseMetricsCredentialsDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"username": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			// Only a hash of the password is stored in state. It is compared
+			// with the hash of the remote password to detect changes made
+			// outside of Terraform.
+			"password": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Sensitive:    true,
+				StateFunc:    util.HashStringStateFunc(),
+				ValidateFunc: validation.NoZeroValues,
+			},
+		},
+	}
+}
+
+func resourceDigitalOceanDatabaseMetricsCredentialsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	if err := updateDatabaseMetricsCredentials(ctx, d, client); err != nil {
+		return diag.Errorf("Error updating database metrics credentials: %s", err)
+	}
+
+	d.SetId("metrics-credentials")
+
+	return resourceDigitalOceanDatabaseMetricsCredentialsRead(ctx, d, meta)
+}
+
+func resourceDigitalOceanDatabaseMetricsCredentialsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	if err := updateDatabaseMetricsCredentials(ctx, d, client); err != nil {
+		return diag.Errorf("Error updating database metrics credentials: %s", err)
+	}
+
+	return resourceDigitalOceanDatabaseMetricsCredentialsRead(ctx, d, meta)
+}
+
+func updateDatabaseMetricsCredentials(ctx context.Context, d *schema.ResourceData, client *godo.Client) error {
+	opts := &godo.DatabaseUpdateMetricsCredentialsRequest{
+		Credentials: &godo.DatabaseMetricsCredentials{
+			BasicAuthUsername: d.Get("username").(string),
+			BasicAuthPassword: d.Get("password").(string),
+		},
+	}
+
+	_, err := client.Databases.UpdateMetricsCredentials(ctx, opts)
+
+	return err
+}
+
+func resourceDigitalOceanDatabaseMetricsCredentialsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	creds, _, err := client.Databases.GetMetricsCredentials(ctx)
+	if err != nil {
+		return diag.Errorf("Error retrieving database metrics credentials: %s", err)
+	}
+
+	d.Set("username", creds.BasicAuthUsername)
+	d.Set("password", util.HashString(creds.BasicAuthPassword))
+
+	return nil
+}
+
+func resourceDigitalOceanDatabaseMetricsCredentialsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId("")
+
+	warn := []diag.Diagnostic{
+		{
+			Severity: diag.Warning,
+			Summary:  "digitalocean_database_metrics_credentials removed from state",
+			Detail:   "Database metrics credentials are only removed from state when destroyed. The remote credentials are not changed.",
+		},
+	}
+
+	return warn
+}
@@ -0,0 +1,62 @@
+package database
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/util"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// The password is sent to the API in the clear, but only its hash may be
+// kept in state.
+func TestDatabaseMetricsCredentialsCreate(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	var creds godo.DatabaseMetricsCredentials
+	mux.HandleFunc("/v2/databases/metrics/credentials", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == http.MethodPut {
+			var req godo.DatabaseUpdateMetricsCredentialsRequest
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				t.Fatalf("error decoding request: %s", err)
+			}
+			creds = *req.Credentials
+			return
+		}
+		fmt.Fprintf(w, `{"credentials": {"basic_auth_username": %q, "basic_auth_password": %q}}`, creds.BasicAuthUsername, creds.BasicAuthPassword)
+	})
+
+	cfg := &config.Config{
+		Token:       "test-token",
+		APIEndpoint: server.URL,
+	}
+	client, err := cfg.Client()
+	if err != nil {
+		t.Fatalf("error building client: %s", err)
+	}
+
+	d := schema.TestResourceDataRaw(t, ResourceDigitalOceanDatabaseMetricsCredentials().Schema, map[string]interface{}{
+		"username": "prometheus",
+		"password": "s3cr3t-passw0rd",
+	})
+
+	if diags := resourceDigitalOceanDatabaseMetricsCredentialsCreate(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("create returned error: %v", diags)
+	}
+
+	if creds.BasicAuthUsername != "prometheus" || creds.BasicAuthPassword != "s3cr3t-passw0rd" {
+		t.Errorf("credentials sent = %+v, expected the configured username and password", creds)
+	}
+	if got := d.Get("password").(string); got != util.HashString("s3cr3t-passw0rd") {
+		t.Errorf("password in state = %q, expected its hash", got)
+	}
+}
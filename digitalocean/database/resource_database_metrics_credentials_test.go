@@ -0,0 +1,65 @@
+package database_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/acceptance"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/util"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccDigitalOceanDatabaseMetricsCredentials_Basic(t *testing.T) {
+	username := acceptance.RandomTestName()
+	password := acctest.RandString(24)
+	rotatedPassword := acctest.RandString(24)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccCheckDigitalOceanDatabaseMetricsCredentialsConfig, username, password),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("digitalocean_database_metrics_credentials.foobar", "username", username),
+					resource.TestCheckResourceAttr("digitalocean_database_metrics_credentials.foobar", "password", util.HashString(password)),
+					testAccCheckDigitalOceanDatabaseMetricsCredentials(username, password),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccCheckDigitalOceanDatabaseMetricsCredentialsConfig, username, rotatedPassword),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("digitalocean_database_metrics_credentials.foobar", "password", util.HashString(rotatedPassword)),
+					testAccCheckDigitalOceanDatabaseMetricsCredentials(username, rotatedPassword),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDigitalOceanDatabaseMetricsCredentials(username string, password string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := acceptance.TestAccProvider.Meta().(*config.CombinedConfig).GodoClient()
+
+		creds, _, err := client.Databases.GetMetricsCredentials(context.Background())
+		if err != nil {
+			return err
+		}
+
+		if creds.BasicAuthUsername != username || creds.BasicAuthPassword != password {
+			return fmt.Errorf("Metrics credentials were not updated")
+		}
+
+		return nil
+	}
+}
+
+const testAccCheckDigitalOceanDatabaseMetricsCredentialsConfig = `
+resource "digitalocean_database_metrics_credentials" "foobar" {
+  username = "%s"
+  password = "%s"
+}`
@@ -0,0 +1,183 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func ResourceDigitalOceanDatabaseOpensearchIndexRetention() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDigitalOceanDatabaseOpensearchIndexRetentionApply,
+		ReadContext:   resourceDigitalOceanDatabaseOpensearchIndexRetentionRead,
+		UpdateContext: resourceDigitalOceanDatabaseOpensearchIndexRetentionApply,
+		DeleteContext: resourceDigitalOceanDatabaseOpensearchIndexRetentionDelete,
+
+		Schema: map[string]*schema.Schema{
+			"cluster_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+				Description:  "UUID of the OpenSearch database cluster",
+			},
+			"index_pattern": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateIndexPattern,
+				Description:  "Glob pattern matching the names of the indexes to apply the retention to, e.g. logs-*",
+			},
+			"retention_days": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "Number of days after which matching indexes are deleted",
+			},
+			"expired_indexes": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Names of the matching indexes which are older than the retention and will be deleted on the next apply",
+			},
+		},
+
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+			// Which indexes a new retention expires is only known once it is
+			// applied. Nothing is deleted until a later plan has listed them.
+			if d.Id() == "" || d.HasChange("retention_days") {
+				return d.SetNewComputed("expired_indexes")
+			}
+
+			// Expired indexes found on refresh are deleted by the next apply.
+			if len(d.Get("expired_indexes").([]interface{})) > 0 {
+				return d.SetNew("expired_indexes", []string{})
+			}
+			return nil
+		},
+	}
+}
+
+func resourceDigitalOceanDatabaseOpensearchIndexRetentionApply(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GodoClient()
+	clusterID := d.Get("cluster_id").(string)
+
+	// Only the indexes listed in the plan are deleted, which are those found
+	// on refresh with an unchanged retention.
+	var expired []interface{}
+	if !d.IsNewResource() && !d.HasChange("retention_days") {
+		old, _ := d.GetChange("expired_indexes")
+		expired = old.([]interface{})
+	}
+
+	for _, v := range expired {
+		name := v.(string)
+		log.Printf("[INFO] Deleting expired index %s of database cluster %s", name, clusterID)
+		resp, err := client.Databases.DeleteIndex(ctx, clusterID, name)
+		if err != nil && (resp == nil || resp.StatusCode != http.StatusNotFound) {
+			return diag.Errorf("Error deleting index %s of database cluster (%s): %s", name, clusterID, err)
+		}
+	}
+
+	if d.IsNewResource() {
+		d.SetId(fmt.Sprintf("%s/%s", clusterID, d.Get("index_pattern").(string)))
+	}
+
+	// The plan is only unknown for a new retention, otherwise it emptied the
+	// list. Indexes which expired since are listed by the next refresh.
+	if d.IsNewResource() || d.HasChange("retention_days") {
+		return resourceDigitalOceanDatabaseOpensearchIndexRetentionRead(ctx, d, meta)
+	}
+
+	d.Set("expired_indexes", []string{})
+
+	return nil
+}
+
+func resourceDigitalOceanDatabaseOpensearchIndexRetentionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GodoClient()
+	clusterID := d.Get("cluster_id").(string)
+
+	indexes, resp, err := client.Databases.ListIndexes(ctx, clusterID, nil)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			log.Printf("[WARN] Database cluster (%s) not found", clusterID)
+			d.SetId("")
+			return nil
+		}
+
+		return diag.Errorf("Error retrieving indexes for database cluster (%s): %s", clusterID, err)
+	}
+
+	expired := expiredOpensearchIndexes(indexes, d.Get("index_pattern").(string), d.Get("retention_days").(int), time.Now())
+	if err := d.Set("expired_indexes", expired); err != nil {
+		return diag.Errorf("Error setting expired_indexes: %s", err)
+	}
+
+	return nil
+}
+
+// The retention only deletes indexes when applied, so there is nothing to
+// remove beyond the resource itself.
+func resourceDigitalOceanDatabaseOpensearchIndexRetentionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId("")
+	return nil
+}
+
+// expiredOpensearchIndexes returns the names of the indexes matching pattern
+// which were created more than retentionDays before now. System indexes, whose
+// names start with a dot, and indexes without a valid creation time are never
+// expired.
+func expiredOpensearchIndexes(indexes []godo.DatabaseIndex, pattern string, retentionDays int, now time.Time) []string {
+	cutoff := now.AddDate(0, 0, -retentionDays)
+
+	expired := []string{}
+	for _, index := range indexes {
+		if strings.HasPrefix(index.IndexName, ".") {
+			continue
+		}
+
+		if matched, _ := path.Match(pattern, index.IndexName); !matched {
+			continue
+		}
+
+		createdAt, err := time.Parse(time.RFC3339, index.CreateTime)
+		if err != nil || !createdAt.Before(cutoff) {
+			continue
+		}
+
+		expired = append(expired, index.IndexName)
+	}
+
+	return expired
+}
+
+// validateIndexPattern validates index_pattern is a valid glob pattern
+func validateIndexPattern(val interface{}, key string) (warns []string, errs []error) {
+	v, ok := val.(string)
+	if !ok {
+		errs = append(errs, fmt.Errorf("%q must be a string", key))
+		return
+	}
+
+	if strings.TrimSpace(v) == "" {
+		errs = append(errs, fmt.Errorf("%q cannot be empty", key))
+		return
+	}
+
+	if _, err := path.Match(v, ""); err != nil {
+		errs = append(errs, fmt.Errorf("%q is not a valid pattern: %s", key, err))
+	}
+
+	return
+}
@@ -0,0 +1,206 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExpiredOpensearchIndexes(t *testing.T) {
+	now := time.Date(2024, 6, 10, 12, 0, 0, 0, time.UTC)
+	indexes := []godo.DatabaseIndex{
+		{IndexName: "logs-2024-05-01", CreateTime: "2024-05-01T00:00:00Z"},
+		{IndexName: "logs-2024-06-02", CreateTime: "2024-06-02T00:00:00Z"},
+		{IndexName: "logs-2024-06-09", CreateTime: "2024-06-09T00:00:00Z"},
+		{IndexName: "metrics-2024-05-01", CreateTime: "2024-05-01T00:00:00Z"},
+		{IndexName: ".opendistro-job-scheduler-lock", CreateTime: "2024-01-01T00:00:00Z"},
+		{IndexName: "logs-unknown", CreateTime: ""},
+	}
+
+	assert.Equal(t, []string{"logs-2024-05-01", "logs-2024-06-02"}, expiredOpensearchIndexes(indexes, "logs-*", 7, now))
+	assert.Equal(t, []string{"logs-2024-05-01"}, expiredOpensearchIndexes(indexes, "logs-*", 30, now))
+	assert.Equal(t, []string{"logs-2024-05-01", "logs-2024-06-02", "metrics-2024-05-01"}, expiredOpensearchIndexes(indexes, "*", 7, now))
+	assert.Equal(t, []string{}, expiredOpensearchIndexes(indexes, "traces-*", 1, now))
+}
+
+// Only the expired indexes shown in the plan are deleted. A changed retention
+// deletes nothing until a later plan has listed what it expires.
+func TestDatabaseOpensearchIndexRetentionApply(t *testing.T) {
+	clusterID := "3f2549b8-9257-4e2f-a04c-e23547b2d685"
+	id := clusterID + "/logs-*"
+
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	var deleted []string
+	mux.HandleFunc(fmt.Sprintf("/v2/databases/%s/indexes", clusterID), func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"indexes": [
+			{"index_name": "logs-2024-05-01", "create_time": "2024-05-01T00:00:00Z"},
+			{"index_name": "logs-2024-05-02", "create_time": "2024-05-02T00:00:00Z"}
+		]}`)
+	})
+	mux.HandleFunc(fmt.Sprintf("/v2/databases/%s/indexes/", clusterID), func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method)
+		deleted = append(deleted, r.URL.Path[len(fmt.Sprintf("/v2/databases/%s/indexes/", clusterID)):])
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	cfg := &config.Config{
+		Token:       "test-token",
+		APIEndpoint: server.URL,
+	}
+	client, err := cfg.Client()
+	if err != nil {
+		t.Fatalf("error building client: %s", err)
+	}
+
+	r := ResourceDigitalOceanDatabaseOpensearchIndexRetention()
+	apply := func(retentionDays int, expired ...string) *terraform.InstanceState {
+		attributes := map[string]string{
+			"id":                id,
+			"cluster_id":        clusterID,
+			"index_pattern":     "logs-*",
+			"retention_days":    "7",
+			"expired_indexes.#": fmt.Sprint(len(expired)),
+		}
+		for i, name := range expired {
+			attributes[fmt.Sprintf("expired_indexes.%d", i)] = name
+		}
+		state := &terraform.InstanceState{ID: id, Attributes: attributes}
+		raw := terraform.NewResourceConfigRaw(map[string]interface{}{
+			"cluster_id":     clusterID,
+			"index_pattern":  "logs-*",
+			"retention_days": retentionDays,
+		})
+
+		diff, err := r.Diff(context.Background(), state, raw, client)
+		if err != nil {
+			t.Fatalf("diff returned error: %s", err)
+		}
+		if diff == nil {
+			return state
+		}
+
+		newState, diags := r.Apply(context.Background(), state, diff, client)
+		if diags.HasError() {
+			t.Fatalf("apply returned error: %v", diags)
+		}
+		return newState
+	}
+
+	// logs-2024-05-02 expired after the refresh and was not in the plan, so it
+	// is left for the next refresh rather than appearing in the applied state.
+	state := apply(7, "logs-2024-05-01")
+	assert.Equal(t, []string{"logs-2024-05-01"}, deleted)
+	assert.Equal(t, "0", state.Attributes["expired_indexes.#"])
+
+	deleted = nil
+	apply(30, "logs-2024-05-01")
+	assert.Empty(t, deleted)
+
+	deleted = nil
+	apply(7)
+	assert.Empty(t, deleted)
+}
+
+// A refresh after an apply lists nothing new unless another index expired in
+// the meantime, so the next plan is empty.
+func TestDatabaseOpensearchIndexRetentionRefreshAfterApply(t *testing.T) {
+	clusterID := "3f2549b8-9257-4e2f-a04c-e23547b2d685"
+	id := clusterID + "/logs-*"
+	recent := time.Now().UTC().Format(time.RFC3339)
+
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	indexes := map[string]string{
+		"logs-2024-05-01": "2024-05-01T00:00:00Z",
+		"logs-recent":     recent,
+	}
+	mux.HandleFunc(fmt.Sprintf("/v2/databases/%s/indexes", clusterID), func(w http.ResponseWriter, r *http.Request) {
+		var list []string
+		for name, created := range indexes {
+			list = append(list, fmt.Sprintf(`{"index_name": %q, "create_time": %q}`, name, created))
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"indexes": [%s]}`, strings.Join(list, ","))
+	})
+	mux.HandleFunc(fmt.Sprintf("/v2/databases/%s/indexes/", clusterID), func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method)
+		delete(indexes, r.URL.Path[len(fmt.Sprintf("/v2/databases/%s/indexes/", clusterID)):])
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	cfg := &config.Config{
+		Token:       "test-token",
+		APIEndpoint: server.URL,
+	}
+	client, err := cfg.Client()
+	if err != nil {
+		t.Fatalf("error building client: %s", err)
+	}
+
+	r := ResourceDigitalOceanDatabaseOpensearchIndexRetention()
+	raw := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"cluster_id":     clusterID,
+		"index_pattern":  "logs-*",
+		"retention_days": 7,
+	})
+	state := &terraform.InstanceState{ID: id, Attributes: map[string]string{
+		"id":                id,
+		"cluster_id":        clusterID,
+		"index_pattern":     "logs-*",
+		"retention_days":    "7",
+		"expired_indexes.#": "0",
+	}}
+
+	state, diags := r.RefreshWithoutUpgrade(context.Background(), state, client)
+	if diags.HasError() {
+		t.Fatalf("refresh returned error: %v", diags)
+	}
+	assert.Equal(t, "1", state.Attributes["expired_indexes.#"])
+
+	diff, err := r.Diff(context.Background(), state, raw, client)
+	if err != nil {
+		t.Fatalf("diff returned error: %s", err)
+	}
+	state, diags = r.Apply(context.Background(), state, diff, client)
+	if diags.HasError() {
+		t.Fatalf("apply returned error: %v", diags)
+	}
+	assert.NotContains(t, indexes, "logs-2024-05-01")
+
+	state, diags = r.RefreshWithoutUpgrade(context.Background(), state, client)
+	if diags.HasError() {
+		t.Fatalf("refresh returned error: %v", diags)
+	}
+	diff, err = r.Diff(context.Background(), state, raw, client)
+	if err != nil {
+		t.Fatalf("diff returned error: %s", err)
+	}
+	assert.Nil(t, diff)
+}
+
+func TestValidateIndexPattern(t *testing.T) {
+	for _, pattern := range []string{"logs-*", "logs-202?-*", "app-[a-c]-*"} {
+		_, errs := validateIndexPattern(pattern, "index_pattern")
+		assert.Empty(t, errs, pattern)
+	}
+
+	for _, pattern := range []string{"", " ", "logs-[", `logs-\`} {
+		_, errs := validateIndexPattern(pattern, "index_pattern")
+		assert.NotEmpty(t, errs, pattern)
+	}
+}
@@ -0,0 +1,45 @@
+package database_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDigitalOceanDatabaseOpensearchIndexRetention_Basic(t *testing.T) {
+	name := acceptance.RandomTestName()
+	dbConfig := fmt.Sprintf(testAccCheckDigitalOceanDatabaseClusterOpensearch, name, "2")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanDatabaseClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccCheckDigitalOceanDatabaseOpensearchIndexRetentionConfig, dbConfig, 7),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("digitalocean_database_opensearch_index_retention.foobar", "index_pattern", "logs-*"),
+					resource.TestCheckResourceAttr("digitalocean_database_opensearch_index_retention.foobar", "retention_days", "7"),
+					resource.TestCheckResourceAttr("digitalocean_database_opensearch_index_retention.foobar", "expired_indexes.#", "0"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccCheckDigitalOceanDatabaseOpensearchIndexRetentionConfig, dbConfig, 30),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("digitalocean_database_opensearch_index_retention.foobar", "retention_days", "30"),
+				),
+			},
+		},
+	})
+}
+
+const testAccCheckDigitalOceanDatabaseOpensearchIndexRetentionConfig = `
+%s
+
+resource "digitalocean_database_opensearch_index_retention" "foobar" {
+  cluster_id     = digitalocean_database_cluster.foobar.id
+  index_pattern  = "logs-*"
+  retention_days = %d
+}`
@@ -130,6 +130,8 @@ func ResourceDigitalOceanDatabaseReplica() *schema.Resource {
 				Computed: true,
 			},
 		},
+
+		CustomizeDiff: validateDatabaseReplicaOptions,
 	}
 }
 
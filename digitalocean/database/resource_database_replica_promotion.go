@@ -0,0 +1,181 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func ResourceDigitalOceanDatabaseReplicaPromotion() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDigitalOceanDatabaseReplicaPromotionCreate,
+		ReadContext:   resourceDigitalOceanDatabaseReplicaPromotionRead,
+		DeleteContext: resourceDigitalOceanDatabaseReplicaPromotionDelete,
+
+		Schema: map[string]*schema.Schema{
+			"cluster_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+				Description:  "The ID of the primary database cluster the replica belongs to.",
+			},
+			"replica_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+				Description:  "The name of the read-only replica to promote.",
+			},
+			"promoted_cluster_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the standalone database cluster the replica was promoted to.",
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"engine": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"size": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"region": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"node_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"private_network_uuid": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+	}
+}
+
+func resourceDigitalOceanDatabaseReplicaPromotionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GodoClient()
+	clusterID := d.Get("cluster_id").(string)
+	replicaName := d.Get("replica_name").(string)
+
+	replica, _, err := client.Databases.GetReplica(ctx, clusterID, replicaName)
+	if err != nil {
+		return diag.Errorf("Error retrieving DatabaseReplica: %s", err)
+	}
+
+	log.Printf("[INFO] Promoting DatabaseReplica %s of database cluster %s to primary", replicaName, clusterID)
+	_, err = client.Databases.PromoteReplicaToPrimary(ctx, clusterID, replicaName)
+	if err != nil {
+		return diag.Errorf("Error promoting DatabaseReplica: %s", err)
+	}
+
+	// A promoted replica keeps its ID as a standalone cluster.
+	d.SetId(replica.ID)
+
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{"promoting"},
+		Target:     []string{"online"},
+		Refresh:    databaseReplicaPromotionRefreshFunc(ctx, client, clusterID, replicaName, replica.ID),
+		Delay:      15 * time.Second,
+		MinTimeout: 15 * time.Second,
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+	}
+
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return diag.Errorf("Error waiting for DatabaseReplica %s to be promoted: %s", replicaName, err)
+	}
+
+	return resourceDigitalOceanDatabaseReplicaPromotionRead(ctx, d, meta)
+}
+
+// databaseReplicaPromotionRefreshFunc reports the promotion as complete once
+// the replica is no longer attached to the primary and the standalone cluster
+// is online.
+func databaseReplicaPromotionRefreshFunc(ctx context.Context, client *godo.Client, clusterID, replicaName, replicaID string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		replica, resp, err := client.Databases.GetReplica(ctx, clusterID, replicaName)
+		if err == nil {
+			return replica, "promoting", nil
+		}
+		if resp == nil || resp.StatusCode != http.StatusNotFound {
+			return nil, "", fmt.Errorf("Error trying to read DatabaseReplica state: %s", err)
+		}
+
+		database, resp, err := client.Databases.Get(ctx, replicaID)
+		if err != nil {
+			if resp != nil && resp.StatusCode == http.StatusNotFound {
+				return replicaID, "promoting", nil
+			}
+			return nil, "", fmt.Errorf("Error trying to read database cluster state: %s", err)
+		}
+
+		if database.Status != "online" {
+			return database, "promoting", nil
+		}
+
+		return database, "online", nil
+	}
+}
+
+func resourceDigitalOceanDatabaseReplicaPromotionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	database, resp, err := client.Databases.Get(ctx, d.Id())
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			log.Printf("[WARN] Promoted database cluster (%s) not found", d.Id())
+			d.SetId("")
+			return nil
+		}
+
+		return diag.Errorf("Error retrieving promoted database cluster: %s", err)
+	}
+
+	d.Set("promoted_cluster_id", database.ID)
+	d.Set("name", database.Name)
+	d.Set("engine", database.EngineSlug)
+	d.Set("version", database.VersionSlug)
+	d.Set("size", database.SizeSlug)
+	d.Set("region", database.RegionSlug)
+	d.Set("node_count", database.NumNodes)
+	d.Set("private_network_uuid", database.PrivateNetworkUUID)
+	d.Set("status", database.Status)
+
+	return nil
+}
+
+// A promotion cannot be undone. Deleting the resource only removes it from
+// state, the promoted cluster is left as is.
+func resourceDigitalOceanDatabaseReplicaPromotionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("[INFO] Removing promotion of database cluster %s from state", d.Id())
+	d.SetId("")
+	return nil
+}
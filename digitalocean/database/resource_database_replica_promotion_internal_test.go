@@ -0,0 +1,73 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDatabaseReplicaPromotionRefreshFunc(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	var (
+		replicaAttached bool
+		clusterStatus   string
+	)
+	notFound := func(w http.ResponseWriter) {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"id": "not_found", "message": "not found"}`)
+	}
+	mux.HandleFunc("/v2/databases/primary-1/replicas/read-01", func(w http.ResponseWriter, r *http.Request) {
+		if !replicaAttached {
+			notFound(w)
+			return
+		}
+		fmt.Fprint(w, `{"replica": {"id": "replica-1", "name": "read-01", "status": "online"}}`)
+	})
+	mux.HandleFunc("/v2/databases/replica-1", func(w http.ResponseWriter, r *http.Request) {
+		if clusterStatus == "" {
+			notFound(w)
+			return
+		}
+		fmt.Fprintf(w, `{"database": {"id": "replica-1", "name": "read-01", "status": "%s"}}`, clusterStatus)
+	})
+
+	cfg := &config.Config{
+		Token:       "test-token",
+		APIEndpoint: server.URL,
+	}
+	combined, err := cfg.Client()
+	if err != nil {
+		t.Fatalf("error building client: %s", err)
+	}
+	refresh := databaseReplicaPromotionRefreshFunc(context.Background(), combined.GodoClient(), "primary-1", "read-01", "replica-1")
+
+	states := []struct {
+		replicaAttached bool
+		clusterStatus   string
+		expected        string
+	}{
+		{true, "", "promoting"},
+		{false, "", "promoting"},
+		{false, "migrating", "promoting"},
+		{false, "online", "online"},
+	}
+	for _, s := range states {
+		replicaAttached = s.replicaAttached
+		clusterStatus = s.clusterStatus
+
+		result, state, err := refresh()
+		if err != nil {
+			t.Fatalf("refresh returned error: %s", err)
+		}
+		assert.NotNil(t, result)
+		assert.Equal(t, s.expected, state, "replica attached: %t, cluster status: %q", s.replicaAttached, s.clusterStatus)
+	}
+}
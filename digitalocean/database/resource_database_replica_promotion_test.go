@@ -0,0 +1,74 @@
+package database_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/acceptance"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccDigitalOceanDatabaseReplicaPromotion_Basic(t *testing.T) {
+	var databaseReplica godo.DatabaseReplica
+	var promotedClusterID string
+
+	databaseName := acceptance.RandomTestName()
+	databaseReplicaName := acceptance.RandomTestName()
+
+	databaseConfig := fmt.Sprintf(testAccCheckDigitalOceanDatabaseClusterConfigBasic, databaseName)
+	replicaConfig := fmt.Sprintf(testAccCheckDigitalOceanDatabaseReplicaConfigBasic, databaseReplicaName)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy: func(s *terraform.State) error {
+			// The promoted cluster is not managed by Terraform.
+			if promotedClusterID != "" {
+				client := acceptance.TestAccProvider.Meta().(*config.CombinedConfig).GodoClient()
+				if _, err := client.Databases.Delete(context.Background(), promotedClusterID); err != nil {
+					return fmt.Errorf("Error deleting promoted database cluster %s: %s", promotedClusterID, err)
+				}
+			}
+
+			return testAccCheckDigitalOceanDatabaseClusterDestroy(s)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: databaseConfig + replicaConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDigitalOceanDatabaseReplicaExists("digitalocean_database_replica.read-01", &databaseReplica),
+				),
+			},
+			{
+				Config: databaseConfig + replicaConfig + testAccCheckDigitalOceanDatabaseReplicaPromotionConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"digitalocean_database_replica_promotion.read-01", "promoted_cluster_id",
+						"digitalocean_database_replica.read-01", "uuid"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_database_replica_promotion.read-01", "name", databaseReplicaName),
+					resource.TestCheckResourceAttr(
+						"digitalocean_database_replica_promotion.read-01", "engine", "pg"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_database_replica_promotion.read-01", "status", "online"),
+					func(s *terraform.State) error {
+						promotedClusterID = s.RootModule().Resources["digitalocean_database_replica_promotion.read-01"].Primary.ID
+						return nil
+					},
+				),
+				// The promoted replica is no longer attached to the primary.
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+const testAccCheckDigitalOceanDatabaseReplicaPromotionConfig = `
+resource "digitalocean_database_replica_promotion" "read-01" {
+  cluster_id   = digitalocean_database_cluster.foobar.id
+  replica_name = digitalocean_database_replica.read-01.name
+}`
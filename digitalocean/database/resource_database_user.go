@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/digitalocean/godo"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
@@ -70,6 +71,31 @@ func ResourceDigitalOceanDatabaseUser() *schema.Resource {
 					},
 				},
 			},
+			"rotation": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"rotate_after_days": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						// changing the trigger rotates the password
+						"rotation_trigger": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"grace_period_hours": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      24,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+					},
+				},
+			},
 			"role": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -79,6 +105,15 @@ func ResourceDigitalOceanDatabaseUser() *schema.Resource {
 				Computed:  true,
 				Sensitive: true,
 			},
+			"password_rotated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"previous_password": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
 			"access_cert": {
 				Type:      schema.TypeString,
 				Computed:  true,
@@ -90,6 +125,8 @@ func ResourceDigitalOceanDatabaseUser() *schema.Resource {
 				Sensitive: true,
 			},
 		},
+
+		CustomizeDiff: customizeDiffDatabaseUserRotation,
 	}
 }
 
@@ -179,6 +216,7 @@ func resourceDigitalOceanDatabaseUserCreate(ctx context.Context, d *schema.Resou
 		return diag.Errorf("Error setting user settings: %#v", err)
 	}
 
+	d.Set("password_rotated_at", time.Now().UTC().Format(time.RFC3339))
 	setDatabaseUserAttributes(d, user)
 
 	return nil
@@ -203,6 +241,7 @@ func resourceDigitalOceanDatabaseUserRead(ctx context.Context, d *schema.Resourc
 	}
 
 	setDatabaseUserAttributes(d, user)
+	refreshDatabaseUserRotation(d, time.Now())
 
 	return nil
 }
@@ -237,23 +276,37 @@ func setDatabaseUserAttributes(d *schema.ResourceData, user *godo.DatabaseUser)
 func resourceDigitalOceanDatabaseUserUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*config.CombinedConfig).GodoClient()
 
-	if d.HasChange("mysql_auth_plugin") {
+	// A new password is only generated when the plan marked one, which it does
+	// for a due rotation or a changed mysql_auth_plugin.
+	if d.HasChange("mysql_auth_plugin") || d.HasChange("password_rotated_at") {
 		authReq := &godo.DatabaseResetUserAuthRequest{}
 		if d.Get("mysql_auth_plugin").(string) != "" {
 			authReq.MySQLSettings = &godo.DatabaseMySQLUserSettings{
 				AuthPlugin: d.Get("mysql_auth_plugin").(string),
 			}
-		} else {
+		} else if d.HasChange("mysql_auth_plugin") {
 			// If blank, restore default value.
 			authReq.MySQLSettings = &godo.DatabaseMySQLUserSettings{
 				AuthPlugin: godo.SQLAuthPluginCachingSHA2,
 			}
 		}
 
-		_, _, err := client.Databases.ResetUserAuth(context.Background(), d.Get("cluster_id").(string), d.Get("name").(string), authReq)
+		// Resetting the auth of a user always generates a new password.
+		previousPassword, _ := d.GetChange("password")
+
+		user, _, err := client.Databases.ResetUserAuth(context.Background(), d.Get("cluster_id").(string), d.Get("name").(string), authReq)
 		if err != nil {
+			// Keep the previous trigger in state so the rotation is retried.
+			d.Partial(true)
+			if !d.HasChange("mysql_auth_plugin") {
+				return diag.Errorf("Error rotating password for DatabaseUser: %s", err)
+			}
 			return diag.Errorf("Error updating mysql_auth_plugin for DatabaseUser: %s", err)
 		}
+
+		d.Set("previous_password", previousPassword)
+		d.Set("password_rotated_at", time.Now().UTC().Format(time.RFC3339))
+		setDatabaseUserAttributes(d, user)
 	}
 	if d.HasChange("settings") {
 		updateReq := &godo.DatabaseUpdateUserRequest{}
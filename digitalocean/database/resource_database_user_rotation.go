@@ -0,0 +1,78 @@
+package database
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// customizeDiffDatabaseUserRotation plans new passwords when a rotation is
+// due. Rotation is only decided here, so the apply performs exactly what the
+// plan showed.
+func customizeDiffDatabaseUserRotation(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	if !databaseUserRotationRequired(d, time.Now()) && !d.HasChange("mysql_auth_plugin") {
+		return nil
+	}
+
+	for _, k := range []string{"password", "previous_password", "password_rotated_at"} {
+		if err := d.SetNewComputed(k); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// databaseUserRotationRequired reports whether the rotation trigger changed
+// or the password is older than rotate_after_days.
+func databaseUserRotationRequired(d *schema.ResourceDiff, now time.Time) bool {
+	if d.HasChange("rotation.0.rotation_trigger") && d.Get("rotation.0.rotation_trigger").(string) != "" {
+		return true
+	}
+
+	return databaseUserPasswordExpired(d.Get("password_rotated_at").(string), d.Get("rotation.0.rotate_after_days").(int), now)
+}
+
+func databaseUserPasswordExpired(rotatedAt string, rotateAfterDays int, now time.Time) bool {
+	if rotateAfterDays == 0 || rotatedAt == "" {
+		return false
+	}
+
+	t, err := time.Parse(time.RFC3339, rotatedAt)
+	if err != nil {
+		return false
+	}
+
+	return !now.Before(t.AddDate(0, 0, rotateAfterDays))
+}
+
+// refreshDatabaseUserRotation starts the rotation period of users created
+// before it was tracked or imported, and forgets the previous password once
+// its grace period has elapsed.
+func refreshDatabaseUserRotation(d *schema.ResourceData, now time.Time) {
+	rotatedAt := d.Get("password_rotated_at").(string)
+	if rotatedAt == "" {
+		d.Set("password_rotated_at", now.UTC().Format(time.RFC3339))
+		d.Set("previous_password", "")
+		return
+	}
+
+	if d.Get("previous_password").(string) == "" {
+		return
+	}
+
+	t, err := time.Parse(time.RFC3339, rotatedAt)
+	if err != nil {
+		return
+	}
+
+	grace := time.Duration(d.Get("rotation.0.grace_period_hours").(int)) * time.Hour
+	if !now.Before(t.Add(grace)) {
+		d.Set("previous_password", "")
+	}
+}
@@ -0,0 +1,198 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDatabaseUserRotationTrigger(t *testing.T) {
+	clusterID := "3f2549b8-9257-4e2f-a04c-e23547b2d685"
+	rotatedAt := time.Now().Add(-time.Hour).UTC().Format(time.RFC3339)
+
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	password := "old-password"
+	var resets int
+	mux.HandleFunc(fmt.Sprintf("/v2/databases/%s/users/app/reset_auth", clusterID), func(w http.ResponseWriter, r *http.Request) {
+		resets++
+		password = "new-password"
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"user": {"name": "app", "role": "normal", "password": %q}}`, password)
+	})
+	mux.HandleFunc(fmt.Sprintf("/v2/databases/%s/users/app", clusterID), func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"user": {"name": "app", "role": "normal", "password": %q}}`, password)
+	})
+
+	cfg := &config.Config{
+		Token:       "test-token",
+		APIEndpoint: server.URL,
+	}
+	client, err := cfg.Client()
+	if err != nil {
+		t.Fatalf("error building client: %s", err)
+	}
+
+	r := ResourceDigitalOceanDatabaseUser()
+	state := &terraform.InstanceState{
+		ID: makeDatabaseUserID(clusterID, "app"),
+		Attributes: map[string]string{
+			"id":                  makeDatabaseUserID(clusterID, "app"),
+			"cluster_id":          clusterID,
+			"name":                "app",
+			"role":                "normal",
+			"password":            "old-password",
+			"password_rotated_at": rotatedAt,
+		},
+	}
+
+	apply := func(trigger string) *terraform.InstanceState {
+		raw := terraform.NewResourceConfigRaw(map[string]interface{}{
+			"cluster_id": clusterID,
+			"name":       "app",
+			"rotation": []interface{}{
+				map[string]interface{}{
+					"rotate_after_days": 30,
+					"rotation_trigger":  trigger,
+				},
+			},
+		})
+
+		diff, err := r.Diff(context.Background(), state, raw, client)
+		if err != nil {
+			t.Fatalf("diff returned error: %s", err)
+		}
+		if diff == nil {
+			return state
+		}
+
+		newState, diags := r.Apply(context.Background(), state, diff, client)
+		if diags.HasError() {
+			t.Fatalf("apply returned error: %v", diags)
+		}
+
+		return newState
+	}
+
+	// Adding the rotation block with a trigger rotates the password.
+	state = apply("2026-10")
+	if resets != 1 {
+		t.Fatalf("resets = %d, expected 1", resets)
+	}
+	if got := state.Attributes["password"]; got != "new-password" {
+		t.Errorf("password = %q, expected %q", got, "new-password")
+	}
+	if got := state.Attributes["previous_password"]; got != "old-password" {
+		t.Errorf("previous_password = %q, expected %q", got, "old-password")
+	}
+	if got := state.Attributes["password_rotated_at"]; got == rotatedAt {
+		t.Error("password_rotated_at was not updated")
+	}
+
+	// An unchanged trigger within rotate_after_days does not rotate again.
+	apply("2026-10")
+	if resets != 1 {
+		t.Errorf("resets = %d, expected 1", resets)
+	}
+}
+
+// A failed rotation keeps the previous trigger and password in state, so the
+// rotation is planned again.
+func TestDatabaseUserRotationFailure(t *testing.T) {
+	clusterID := "3f2549b8-9257-4e2f-a04c-e23547b2d685"
+	rotatedAt := time.Now().Add(-time.Hour).UTC().Format(time.RFC3339)
+
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	mux.HandleFunc(fmt.Sprintf("/v2/databases/%s/users/app/reset_auth", clusterID), func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		fmt.Fprint(w, `{"id": "unprocessable_entity", "message": "cluster is busy"}`)
+	})
+
+	cfg := &config.Config{
+		Token:       "test-token",
+		APIEndpoint: server.URL,
+	}
+	client, err := cfg.Client()
+	if err != nil {
+		t.Fatalf("error building client: %s", err)
+	}
+
+	r := ResourceDigitalOceanDatabaseUser()
+	state := &terraform.InstanceState{
+		ID: makeDatabaseUserID(clusterID, "app"),
+		Attributes: map[string]string{
+			"id":                            makeDatabaseUserID(clusterID, "app"),
+			"cluster_id":                    clusterID,
+			"name":                          "app",
+			"role":                          "normal",
+			"password":                      "old-password",
+			"password_rotated_at":           rotatedAt,
+			"rotation.#":                    "1",
+			"rotation.0.rotate_after_days":  "0",
+			"rotation.0.rotation_trigger":   "2026-09",
+			"rotation.0.grace_period_hours": "24",
+		},
+	}
+	raw := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"cluster_id": clusterID,
+		"name":       "app",
+		"rotation": []interface{}{
+			map[string]interface{}{
+				"rotation_trigger": "2026-10",
+			},
+		},
+	})
+
+	diff, err := r.Diff(context.Background(), state, raw, client)
+	if err != nil {
+		t.Fatalf("diff returned error: %s", err)
+	}
+
+	newState, diags := r.Apply(context.Background(), state, diff, client)
+	if !diags.HasError() {
+		t.Fatal("expected the rotation to fail")
+	}
+	assert.Contains(t, diags[0].Summary, "Error rotating password for DatabaseUser")
+	assert.Equal(t, "2026-09", newState.Attributes["rotation.0.rotation_trigger"])
+	assert.Equal(t, "old-password", newState.Attributes["password"])
+	assert.Equal(t, rotatedAt, newState.Attributes["password_rotated_at"])
+}
+
+func TestDatabaseUserPasswordExpired(t *testing.T) {
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		name            string
+		rotatedAt       string
+		rotateAfterDays int
+		expected        bool
+	}{
+		{name: "no rotation period", rotatedAt: "2026-01-01T00:00:00Z"},
+		{name: "unknown rotation time", rotateAfterDays: 30},
+		{name: "within period", rotatedAt: "2026-10-01T00:00:00Z", rotateAfterDays: 30},
+		{name: "period elapsed", rotatedAt: "2026-09-19T12:00:00Z", rotateAfterDays: 30, expected: true},
+		{name: "unparseable rotation time", rotatedAt: "yesterday", rotateAfterDays: 1},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := databaseUserPasswordExpired(tc.rotatedAt, tc.rotateAfterDays, now); got != tc.expected {
+				t.Errorf("databaseUserPasswordExpired() = %t, expected %t", got, tc.expected)
+			}
+		})
+	}
+}
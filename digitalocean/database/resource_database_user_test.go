@@ -319,6 +319,52 @@ func TestAccDigitalOceanDatabaseUser_OpenSearchACLs(t *testing.T) {
 	})
 }
 
+func TestAccDigitalOceanDatabaseUser_RotationTrigger(t *testing.T) {
+	var password string
+	databaseClusterName := acceptance.RandomTestName()
+	databaseUserName := acceptance.RandomTestName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanDatabaseUserDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccCheckDigitalOceanDatabaseUserConfigRotation, databaseClusterName, databaseUserName, "initial"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(
+						"digitalocean_database_user.foobar_user", "password_rotated_at"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_database_user.foobar_user", "previous_password", ""),
+					func(s *terraform.State) error {
+						password = s.RootModule().Resources["digitalocean_database_user.foobar_user"].Primary.Attributes["password"]
+						return nil
+					},
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccCheckDigitalOceanDatabaseUserConfigRotation, databaseClusterName, databaseUserName, "rotated"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrWith(
+						"digitalocean_database_user.foobar_user", "previous_password", func(v string) error {
+							if v != password {
+								return fmt.Errorf("previous_password is not the password before rotation")
+							}
+							return nil
+						}),
+					resource.TestCheckResourceAttrWith(
+						"digitalocean_database_user.foobar_user", "password", func(v string) error {
+							if v == "" || v == password {
+								return fmt.Errorf("password was not rotated")
+							}
+							return nil
+						}),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckDigitalOceanDatabaseUserDestroy(s *terraform.State) error {
 	client := acceptance.TestAccProvider.Meta().(*config.CombinedConfig).GodoClient()
 
@@ -641,3 +687,23 @@ resource "digitalocean_database_user" "foobar_user" {
   cluster_id = digitalocean_database_cluster.foobar.id
   name       = "%s"
 }`
+
+const testAccCheckDigitalOceanDatabaseUserConfigRotation = `
+resource "digitalocean_database_cluster" "foobar" {
+  name       = "%s"
+  engine     = "pg"
+  version    = "15"
+  size       = "db-s-1vcpu-1gb"
+  region     = "nyc1"
+  node_count = 1
+}
+
+resource "digitalocean_database_user" "foobar_user" {
+  cluster_id = digitalocean_database_cluster.foobar.id
+  name       = "%s"
+
+  rotation {
+    rotate_after_days = 30
+    rotation_trigger  = "%s"
+  }
+}`
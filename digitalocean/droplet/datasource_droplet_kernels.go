@@ -0,0 +1,90 @@
+package droplet
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/digitalocean/terraform-provider-digitalocean/internal/datalist"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func DataSourceDigitalOceanDropletKernels() *schema.Resource {
+	dataListConfig := &datalist.ResourceConfig{
+		RecordSchema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeInt,
+				Description: "id of the kernel",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Description: "name of the kernel",
+			},
+			"version": {
+				Type:        schema.TypeString,
+				Description: "version of the kernel",
+			},
+		},
+		ResultAttributeName: "kernels",
+		FlattenRecord:       flattenDigitalOceanDropletKernel,
+		GetRecords:          getDigitalOceanDropletKernels,
+		ExtraQuerySchema: map[string]*schema.Schema{
+			"droplet_id": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+		},
+	}
+
+	return datalist.NewResource(dataListConfig)
+}
+
+func getDigitalOceanDropletKernels(meta interface{}, extra map[string]interface{}) ([]interface{}, error) {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	dropletID := extra["droplet_id"].(int)
+
+	opts := &godo.ListOptions{
+		Page:    1,
+		PerPage: 200,
+	}
+
+	var kernelList []interface{}
+
+	for {
+		kernels, resp, err := client.Droplets.Kernels(context.Background(), dropletID, opts)
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving kernels for droplet (%d): %s", dropletID, err)
+		}
+
+		for _, kernel := range kernels {
+			kernelList = append(kernelList, kernel)
+		}
+
+		if resp.Links == nil || resp.Links.IsLastPage() {
+			break
+		}
+
+		page, err := resp.Links.CurrentPage()
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving kernels for droplet (%d): %s", dropletID, err)
+		}
+
+		opts.Page = page + 1
+	}
+
+	return kernelList, nil
+}
+
+func flattenDigitalOceanDropletKernel(rawKernel, meta interface{}, extra map[string]interface{}) (map[string]interface{}, error) {
+	kernel := rawKernel.(godo.Kernel)
+
+	return map[string]interface{}{
+		"id":      kernel.ID,
+		"name":    kernel.Name,
+		"version": kernel.Version,
+	}, nil
+}
@@ -0,0 +1,45 @@
+package droplet_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceDigitalOceanDropletKernels_Basic(t *testing.T) {
+	name := acceptance.RandomTestName()
+	resourceConfig := fmt.Sprintf(`
+resource "digitalocean_droplet" "foo" {
+  name   = "%s"
+  size   = "s-1vcpu-1gb"
+  image  = "ubuntu-22-04-x64"
+  region = "nyc3"
+}`, name)
+	dataSourceConfig := `
+data "digitalocean_droplet_kernels" "foobar" {
+  droplet_id = digitalocean_droplet.foo.id
+
+  sort {
+    key       = "name"
+    direction = "asc"
+  }
+}`
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: resourceConfig,
+			},
+			{
+				Config: resourceConfig + dataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.digitalocean_droplet_kernels.foobar", "kernels.#"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,73 @@
+package droplet
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/digitalocean/terraform-provider-digitalocean/internal/datalist"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceDigitalOceanDropletSupportedBackupPolicies() *schema.Resource {
+	dataListConfig := &datalist.ResourceConfig{
+		RecordSchema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Description: "name of the backup plan, e.g. daily or weekly",
+			},
+			"possible_window_starts": {
+				Type:        schema.TypeList,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+				Description: "the hours of the day at which a backup window may start",
+			},
+			"window_length_hours": {
+				Type:        schema.TypeInt,
+				Description: "the length of the backup window in hours",
+			},
+			"retention_period_days": {
+				Type:        schema.TypeInt,
+				Description: "the number of days backups are retained",
+			},
+			"possible_days": {
+				Type:        schema.TypeList,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "the days of the week on which a backup may occur",
+			},
+		},
+		ResultAttributeName: "supported_policies",
+		FlattenRecord:       flattenDigitalOceanDropletSupportedBackupPolicy,
+		GetRecords:          getDigitalOceanDropletSupportedBackupPolicies,
+	}
+
+	return datalist.NewResource(dataListConfig)
+}
+
+func getDigitalOceanDropletSupportedBackupPolicies(meta interface{}, extra map[string]interface{}) ([]interface{}, error) {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	policies, _, err := client.Droplets.ListSupportedBackupPolicies(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving supported droplet backup policies: %s", err)
+	}
+
+	var policyList []interface{}
+	for _, policy := range policies {
+		policyList = append(policyList, *policy)
+	}
+
+	return policyList, nil
+}
+
+func flattenDigitalOceanDropletSupportedBackupPolicy(rawPolicy, meta interface{}, extra map[string]interface{}) (map[string]interface{}, error) {
+	policy := rawPolicy.(godo.SupportedBackupPolicy)
+
+	return map[string]interface{}{
+		"name":                   policy.Name,
+		"possible_window_starts": policy.PossibleWindowStarts,
+		"window_length_hours":    policy.WindowLengthHours,
+		"retention_period_days":  policy.RetentionPeriodDays,
+		"possible_days":          policy.PossibleDays,
+	}, nil
+}
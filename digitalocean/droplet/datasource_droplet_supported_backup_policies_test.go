@@ -0,0 +1,38 @@
+package droplet_test
+
+import (
+	"testing"
+
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceDigitalOceanDropletSupportedBackupPolicies_Basic(t *testing.T) {
+	config := `
+data "digitalocean_droplet_supported_backup_policies" "weekly" {
+  filter {
+    key    = "name"
+    values = ["weekly"]
+  }
+}`
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.digitalocean_droplet_supported_backup_policies.weekly", "supported_policies.#", "1"),
+					resource.TestCheckResourceAttr(
+						"data.digitalocean_droplet_supported_backup_policies.weekly", "supported_policies.0.name", "weekly"),
+					resource.TestCheckResourceAttrSet(
+						"data.digitalocean_droplet_supported_backup_policies.weekly", "supported_policies.0.retention_period_days"),
+					resource.TestCheckResourceAttrSet(
+						"data.digitalocean_droplet_supported_backup_policies.weekly", "supported_policies.0.possible_days.#"),
+				),
+			},
+		},
+	})
+}
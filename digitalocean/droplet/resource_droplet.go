@@ -281,6 +281,66 @@ func ResourceDigitalOceanDroplet() *schema.Resource {
 				ValidateFunc: validation.NoZeroValues,
 			},
 
+			"kernel_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.NoZeroValues,
+				Description:  "The ID of an externally managed kernel to boot the Droplet with. Changing this power cycles the Droplet.",
+			},
+
+			"destroy_all_associated_resources": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				Default:       false,
+				ConflictsWith: []string{"destroy_associated_resources"},
+				Description:   "Destroy all volumes, snapshots, volume snapshots, and reserved IPs associated with the Droplet when it is destroyed.",
+			},
+
+			"destroy_associated_resources": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"destroy_all_associated_resources"},
+				Description:   "The associated resources to destroy along with the Droplet when it is destroyed.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"volumes": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.NoZeroValues,
+							},
+						},
+						"snapshots": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.NoZeroValues,
+							},
+						},
+						"volume_snapshots": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.NoZeroValues,
+							},
+						},
+						"reserved_ips": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.NoZeroValues,
+							},
+						},
+					},
+				},
+			},
+
 			"gpu_partition_mode": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -316,6 +376,14 @@ func ResourceDigitalOceanDroplet() *schema.Resource {
 					return old.(bool) && !new.(bool)
 				},
 			),
+			// Validate backup_policy against the policies supported by the API
+			// so that an unsupported plan, weekday, or hour fails at plan time.
+			customdiff.IfValueChange("backup_policy",
+				func(ctx context.Context, old, new, meta interface{}) bool {
+					return len(new.([]interface{})) > 0
+				},
+				validateDropletBackupPolicy,
+			),
 		),
 	}
 }
@@ -433,6 +501,11 @@ func resourceDigitalOceanDropletCreate(ctx context.Context, d *schema.ResourceDa
 		return diag.FromErr(err)
 	}
 
+	// The kernel can not be set on create, so it is changed once the Droplet is
+	// active. Clear it so that it only reflects the kernel reported by the API.
+	kernelID := d.Get("kernel_id").(int)
+	d.Set("kernel_id", 0)
+
 	log.Printf("[DEBUG] Droplet create configuration: %#v", opts)
 
 	droplet, _, err := client.Droplets.Create(context.Background(), opts)
@@ -450,6 +523,14 @@ func resourceDigitalOceanDropletCreate(ctx context.Context, d *schema.ResourceDa
 		return diag.Errorf("Error waiting for droplet (%s) to become ready: %s", d.Id(), err)
 	}
 
+	if kernelID != 0 && d.Get("kernel_id").(int) != kernelID {
+		if err := changeDropletKernel(ctx, d, meta, kernelID); err != nil {
+			return diag.FromErr(err)
+		}
+
+		return resourceDigitalOceanDropletRead(ctx, d, meta)
+	}
+
 	// waitForDropletAttribute updates the Droplet's state and calls setDropletAttributes.
 	// So there is no need to call resourceDigitalOceanDropletRead and add additional API calls.
 	return nil
@@ -504,6 +585,10 @@ func setDropletAttributes(d *schema.ResourceData, droplet *godo.Droplet) error {
 	d.Set("created_at", droplet.Created)
 	d.Set("vpc_uuid", droplet.VPCUUID)
 
+	if droplet.Kernel != nil {
+		d.Set("kernel_id", droplet.Kernel.ID)
+	}
+
 	// The API only returns gpu_partition_mode on the create response; it is not
 	// currently returned when reading a Droplet. Only set it when populated so the
 	// configured value is preserved rather than being cleared on a refresh.
@@ -673,6 +758,14 @@ func resourceDigitalOceanDropletUpdate(ctx context.Context, d *schema.ResourceDa
 		}
 	}
 
+	if d.HasChange("kernel_id") {
+		if kernelID := d.Get("kernel_id").(int); kernelID != 0 {
+			if err := changeDropletKernel(ctx, d, meta, kernelID); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
 	if d.HasChange("backups") {
 		if d.Get("backups").(bool) {
 			// Enable backups on droplet
@@ -877,8 +970,24 @@ func resourceDigitalOceanDropletDelete(ctx context.Context, d *schema.ResourceDa
 		}
 	}
 
+	destroyAll := d.Get("destroy_all_associated_resources").(bool)
+	selective := expandDropletDeleteSelectiveRequest(d.Get("destroy_associated_resources").([]interface{}))
+
+	// Volumes destroyed along with the Droplet must remain attached, otherwise
+	// the API no longer considers them associated with it.
+	var keepAttached []string
+	if destroyAll {
+		if attr, ok := d.GetOk("volume_ids"); ok {
+			for _, volumeID := range attr.(*schema.Set).List() {
+				keepAttached = append(keepAttached, volumeID.(string))
+			}
+		}
+	} else if selective != nil {
+		keepAttached = selective.Volumes
+	}
+
 	log.Printf("[INFO] Trying to Detach Storage Volumes (if any) from droplet: %s", d.Id())
-	err = detachVolumesFromDroplet(d, meta)
+	err = detachVolumesFromDroplet(d, meta, keepAttached...)
 	if err != nil {
 		return diag.Errorf(
 			"Error detaching the volumes from the droplet (%s): %s", d.Id(), err)
@@ -887,13 +996,27 @@ func resourceDigitalOceanDropletDelete(ctx context.Context, d *schema.ResourceDa
 	log.Printf("[INFO] Deleting droplet: %s", d.Id())
 
 	// Destroy the droplet
-	resp, err := client.Droplets.Delete(context.Background(), id)
+	var resp *godo.Response
+	switch {
+	case destroyAll:
+		log.Printf("[WARN] destroy_all_associated_resources set to true. All resources (volumes, snapshots, volume snapshots, and reserved IPs) associated with the droplet will be destroyed.")
+		resp, err = deleteDropletDangerous(ctx, client, id)
+	case selective != nil:
+		log.Printf("[WARN] The following resources will be destroyed along with the droplet: %s", godo.Stringify(selective))
+		resp, err = deleteDropletSelective(ctx, client, id, selective)
+	default:
+		resp, err = client.Droplets.Delete(context.Background(), id)
+	}
 
 	// Handle already destroyed droplets
-	if err != nil && resp.StatusCode == 404 {
+	if err != nil && resp != nil && resp.StatusCode == 404 {
 		return nil
 	}
 
+	if err != nil && (destroyAll || selective != nil) {
+		return diag.Errorf("Error deleting droplet (%s) with associated resources: %s", d.Id(), err)
+	}
+
 	_, err = waitForDropletDestroy(ctx, d, meta)
 	if err != nil && strings.Contains(err.Error(), "404") {
 		return nil
@@ -904,6 +1027,65 @@ func resourceDigitalOceanDropletDelete(ctx context.Context, d *schema.ResourceDa
 	return nil
 }
 
+// dropletDeleteSelectiveRequest lists the associated resources to destroy
+// along with a Droplet.
+type dropletDeleteSelectiveRequest struct {
+	Volumes         []string `json:"volumes,omitempty"`
+	Snapshots       []string `json:"snapshots,omitempty"`
+	VolumeSnapshots []string `json:"volume_snapshots,omitempty"`
+	ReservedIPs     []string `json:"reserved_ips,omitempty"`
+}
+
+func expandDropletDeleteSelectiveRequest(config []interface{}) *dropletDeleteSelectiveRequest {
+	if len(config) == 0 || config[0] == nil {
+		return nil
+	}
+
+	rawResources := config[0].(map[string]interface{})
+	expandIDs := func(key string) []string {
+		set, ok := rawResources[key].(*schema.Set)
+		if !ok {
+			return nil
+		}
+		ids := make([]string, 0, set.Len())
+		for _, id := range set.List() {
+			ids = append(ids, id.(string))
+		}
+		slices.Sort(ids)
+		return ids
+	}
+
+	return &dropletDeleteSelectiveRequest{
+		Volumes:         expandIDs("volumes"),
+		Snapshots:       expandIDs("snapshots"),
+		VolumeSnapshots: expandIDs("volume_snapshots"),
+		ReservedIPs:     expandIDs("reserved_ips"),
+	}
+}
+
+// godo does not yet expose the Droplet destroy_with_associated_resources
+// endpoints, so the requests are issued directly using the godo client.
+func deleteDropletSelective(ctx context.Context, client *godo.Client, id int, request *dropletDeleteSelectiveRequest) (*godo.Response, error) {
+	path := fmt.Sprintf("v2/droplets/%d/destroy_with_associated_resources/selective", id)
+	req, err := client.NewRequest(ctx, http.MethodDelete, path, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.Do(ctx, req, nil)
+}
+
+func deleteDropletDangerous(ctx context.Context, client *godo.Client, id int) (*godo.Response, error) {
+	path := fmt.Sprintf("v2/droplets/%d/destroy_with_associated_resources/dangerous", id)
+	req, err := client.NewRequest(ctx, http.MethodDelete, path, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("X-Dangerous", "true")
+
+	return client.Do(ctx, req, nil)
+}
+
 func waitForDropletDestroy(ctx context.Context, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	log.Printf("[INFO] Waiting for droplet (%s) to be destroyed", d.Id())
 
@@ -1009,6 +1191,35 @@ func dropletStateRefreshFunc(
 	}
 }
 
+// Changes the droplet's kernel and power cycles it to boot the new kernel
+func changeDropletKernel(ctx context.Context, d *schema.ResourceData, meta interface{}, kernelID int) error {
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return fmt.Errorf("invalid droplet id: %v", err)
+	}
+
+	client := meta.(*config.CombinedConfig).GodoClient()
+	action, _, err := client.DropletActions.ChangeKernel(ctx, id, kernelID)
+	if err != nil {
+		return fmt.Errorf("Error changing kernel of droplet (%s) to %d: %s", d.Id(), kernelID, err)
+	}
+
+	if err := util.WaitForAction(client, action); err != nil {
+		return fmt.Errorf("Error waiting for kernel of droplet (%s) to change: %s", d.Id(), err)
+	}
+
+	action, _, err = client.DropletActions.PowerCycle(ctx, id)
+	if err != nil {
+		return fmt.Errorf("Error power cycling droplet (%s) after kernel change: %s", d.Id(), err)
+	}
+
+	if err := util.WaitForAction(client, action); err != nil {
+		return fmt.Errorf("Error waiting for droplet (%s) to power cycle: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
 // Powers on the droplet and waits for it to be active
 func powerOnAndWait(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	id, err := strconv.Atoi(d.Id())
@@ -1031,12 +1242,16 @@ func powerOnAndWait(ctx context.Context, d *schema.ResourceData, meta interface{
 	return nil
 }
 
-// Detach volumes from droplet
-func detachVolumesFromDroplet(d *schema.ResourceData, meta interface{}) error {
+// Detach volumes from droplet, except for those listed in skip
+func detachVolumesFromDroplet(d *schema.ResourceData, meta interface{}, skip ...string) error {
 	var errors []error
 	if attr, ok := d.GetOk("volume_ids"); ok {
 		errors = make([]error, 0, attr.(*schema.Set).Len())
 		for _, volumeID := range attr.(*schema.Set).List() {
+			if slices.Contains(skip, volumeID.(string)) {
+				log.Printf("[INFO] Leaving volume %q attached to be destroyed with droplet: %s", volumeID, d.Id())
+				continue
+			}
 			err := detachVolumeIDOnDroplet(d, volumeID.(string), meta)
 			if err != nil {
 				return err
@@ -1096,6 +1311,56 @@ func flattenDigitalOceanDropletVolumeIds(volumeids []string) *schema.Set {
 	return flattenedVolumes
 }
 
+func validateDropletBackupPolicy(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("backup_policy") {
+		return nil
+	}
+
+	policy, err := expandBackupPolicy(diff.Get("backup_policy"))
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*config.CombinedConfig).GodoClient()
+	supported, _, err := client.Droplets.ListSupportedBackupPolicies(ctx)
+	if err != nil {
+		return fmt.Errorf("Error retrieving supported droplet backup policies: %s", err)
+	}
+
+	return checkBackupPolicySupported(policy, supported)
+}
+
+// checkBackupPolicySupported returns an error if the backup policy's plan,
+// weekday, or hour is not allowed by any of the supported policies.
+func checkBackupPolicySupported(policy *godo.DropletBackupPolicyRequest, supported []*godo.SupportedBackupPolicy) error {
+	if policy.Plan == "" || len(supported) == 0 {
+		return nil
+	}
+
+	var plans []string
+	for _, s := range supported {
+		if s == nil {
+			continue
+		}
+		if s.Name != policy.Plan {
+			plans = append(plans, s.Name)
+			continue
+		}
+
+		if policy.Weekday != "" && len(s.PossibleDays) > 0 && !slices.Contains(s.PossibleDays, policy.Weekday) {
+			return fmt.Errorf("backup_policy weekday %q is not supported for the %s plan, expected one of %v", policy.Weekday, s.Name, s.PossibleDays)
+		}
+
+		if policy.Hour != nil && len(s.PossibleWindowStarts) > 0 && !slices.Contains(s.PossibleWindowStarts, *policy.Hour) {
+			return fmt.Errorf("backup_policy hour %d is not supported for the %s plan, expected one of %v", *policy.Hour, s.Name, s.PossibleWindowStarts)
+		}
+
+		return nil
+	}
+
+	return fmt.Errorf("backup_policy plan %q is not supported, expected one of %v", policy.Plan, plans)
+}
+
 func expandBackupPolicy(v interface{}) (*godo.DropletBackupPolicyRequest, error) {
 	var policy godo.DropletBackupPolicyRequest
 	policyList := v.([]interface{})
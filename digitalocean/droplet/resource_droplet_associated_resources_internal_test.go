@@ -0,0 +1,90 @@
+package droplet
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExpandDropletDeleteSelectiveRequest(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, ResourceDigitalOceanDroplet().Schema, map[string]interface{}{
+		"image": "ubuntu-24-04-x64",
+		"name":  "foo",
+		"size":  "s-1vcpu-1gb",
+		"destroy_associated_resources": []interface{}{
+			map[string]interface{}{
+				"volumes":      []interface{}{"vol-b", "vol-a"},
+				"reserved_ips": []interface{}{"192.0.2.10"},
+			},
+		},
+	})
+
+	req := expandDropletDeleteSelectiveRequest(d.Get("destroy_associated_resources").([]interface{}))
+	if req == nil {
+		t.Fatal("expected a selective delete request")
+	}
+	assert.Equal(t, []string{"vol-a", "vol-b"}, req.Volumes)
+	assert.Equal(t, []string{"192.0.2.10"}, req.ReservedIPs)
+	assert.Empty(t, req.Snapshots)
+	assert.Empty(t, req.VolumeSnapshots)
+
+	assert.Nil(t, expandDropletDeleteSelectiveRequest([]interface{}{}))
+}
+
+func TestDeleteDropletWithAssociatedResources(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	mux.HandleFunc("/v2/droplets/123/destroy_with_associated_resources/selective", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			t.Errorf("method = %v, expected %v", r.Method, http.MethodDelete)
+		}
+
+		var body map[string][]string
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("error decoding request body: %s", err)
+		}
+		assert.Equal(t, map[string][]string{"snapshots": {"snap-1"}}, body)
+
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	mux.HandleFunc("/v2/droplets/123/destroy_with_associated_resources/dangerous", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			t.Errorf("method = %v, expected %v", r.Method, http.MethodDelete)
+		}
+		if got := r.Header.Get("X-Dangerous"); got != "true" {
+			t.Errorf("X-Dangerous header = %q, expected %q", got, "true")
+		}
+
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	cfg := &config.Config{
+		Token:       "test-token",
+		APIEndpoint: server.URL,
+	}
+	combined, err := cfg.Client()
+	if err != nil {
+		t.Fatalf("error building client: %s", err)
+	}
+	client := combined.GodoClient()
+
+	_, err = deleteDropletSelective(context.Background(), client, 123, &dropletDeleteSelectiveRequest{
+		Snapshots: []string{"snap-1"},
+	})
+	if err != nil {
+		t.Fatalf("selective delete returned error: %s", err)
+	}
+
+	if _, err := deleteDropletDangerous(context.Background(), client, 123); err != nil {
+		t.Fatalf("dangerous delete returned error: %s", err)
+	}
+}
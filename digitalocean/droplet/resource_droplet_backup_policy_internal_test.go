@@ -0,0 +1,67 @@
+package droplet
+
+import (
+	"testing"
+
+	"github.com/digitalocean/godo"
+)
+
+func TestCheckBackupPolicySupported(t *testing.T) {
+	supported := []*godo.SupportedBackupPolicy{
+		{
+			Name:                 "daily",
+			PossibleWindowStarts: []int{0, 4, 8, 12, 16, 20},
+			WindowLengthHours:    4,
+			RetentionPeriodDays:  7,
+		},
+		{
+			Name:                 "weekly",
+			PossibleWindowStarts: []int{0, 4, 8, 12, 16, 20},
+			WindowLengthHours:    4,
+			RetentionPeriodDays:  28,
+			PossibleDays:         []string{"SUN", "MON", "TUE", "WED", "THU", "FRI", "SAT"},
+		},
+	}
+
+	cases := []struct {
+		name        string
+		policy      *godo.DropletBackupPolicyRequest
+		expectError bool
+	}{
+		{
+			name:   "daily",
+			policy: &godo.DropletBackupPolicyRequest{Plan: "daily", Hour: godo.PtrTo(8)},
+		},
+		{
+			name:   "weekly",
+			policy: &godo.DropletBackupPolicyRequest{Plan: "weekly", Weekday: "TUE", Hour: godo.PtrTo(20)},
+		},
+		{
+			name:        "unsupported plan",
+			policy:      &godo.DropletBackupPolicyRequest{Plan: "monthly", Hour: godo.PtrTo(0)},
+			expectError: true,
+		},
+		{
+			name:        "unsupported hour",
+			policy:      &godo.DropletBackupPolicyRequest{Plan: "daily", Hour: godo.PtrTo(6)},
+			expectError: true,
+		},
+		{
+			name:        "unsupported weekday",
+			policy:      &godo.DropletBackupPolicyRequest{Plan: "weekly", Weekday: "FUNDAY", Hour: godo.PtrTo(0)},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := checkBackupPolicySupported(tc.policy, supported)
+			if tc.expectError && err == nil {
+				t.Error("expected an error")
+			}
+			if !tc.expectError && err != nil {
+				t.Errorf("unexpected error: %s", err)
+			}
+		})
+	}
+}
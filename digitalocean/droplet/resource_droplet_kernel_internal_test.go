@@ -0,0 +1,26 @@
+package droplet
+
+import (
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetDropletAttributes_Kernel(t *testing.T) {
+	d := ResourceDigitalOceanDroplet().TestResourceData()
+
+	droplet := &godo.Droplet{
+		ID:       123,
+		Name:     "legacy",
+		Region:   &godo.Region{Slug: "nyc3"},
+		Size:     &godo.Size{Slug: "s-1vcpu-1gb"},
+		Networks: &godo.Networks{},
+		Kernel:   &godo.Kernel{ID: 7515, Name: "Ubuntu 14.04 x64 vmlinuz-3.13.0-24-generic"},
+	}
+
+	if err := setDropletAttributes(d, droplet); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	assert.Equal(t, 7515, d.Get("kernel_id"))
+}
@@ -0,0 +1,49 @@
+package dropletautoscale_test
+
+import (
+	"testing"
+
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceDigitalOceanDropletAutoscaleHistory_Basic(t *testing.T) {
+	name := acceptance.RandomTestName()
+
+	createConfig := testAccCheckDigitalOceanDropletAutoscaleConfig_static(name, 1)
+	dataSourceConfig := `
+data "digitalocean_droplet_autoscale_history" "foo" {
+  autoscale_pool_id = digitalocean_droplet_autoscale.foobar.id
+
+  sort {
+    key       = "created_at"
+    direction = "desc"
+  }
+}`
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanDropletAutoscaleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: createConfig,
+			},
+			{
+				Config: createConfig + dataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(
+						"data.digitalocean_droplet_autoscale_history.foo", "history.0.history_event_id"),
+					resource.TestCheckResourceAttr(
+						"data.digitalocean_droplet_autoscale_history.foo", "history.0.desired_instance_count", "1"),
+					resource.TestCheckResourceAttrSet(
+						"data.digitalocean_droplet_autoscale_history.foo", "history.0.reason"),
+					resource.TestCheckResourceAttrSet(
+						"data.digitalocean_droplet_autoscale_history.foo", "history.0.status"),
+					resource.TestCheckResourceAttrSet(
+						"data.digitalocean_droplet_autoscale_history.foo", "history.0.created_at"),
+				),
+			},
+		},
+	})
+}
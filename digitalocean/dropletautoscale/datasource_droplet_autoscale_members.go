@@ -0,0 +1,169 @@
+package dropletautoscale
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/droplet"
+	"github.com/digitalocean/terraform-provider-digitalocean/internal/datalist"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// dropletAutoscaleMember pairs an autoscale pool member with the Droplet
+// backing it so its addresses can be exposed alongside the member status.
+type dropletAutoscaleMember struct {
+	member  *godo.DropletAutoscaleResource
+	droplet *godo.Droplet
+}
+
+func DataSourceDigitalOceanDropletAutoscaleMembers() *schema.Resource {
+	dataListConfig := &datalist.ResourceConfig{
+		RecordSchema: map[string]*schema.Schema{
+			"droplet_id": {
+				Type:        schema.TypeInt,
+				Description: "ID of the member Droplet",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Description: "Name of the member Droplet",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Description: "Provisioning status of the member",
+			},
+			"health_status": {
+				Type:        schema.TypeString,
+				Description: "Health status of the member",
+			},
+			"unhealthy_reason": {
+				Type:        schema.TypeString,
+				Description: "Reason the member is unhealthy, if any",
+			},
+			"cpu_utilization": {
+				Type:        schema.TypeFloat,
+				Description: "Current CPU utilization of the member",
+			},
+			"memory_utilization": {
+				Type:        schema.TypeFloat,
+				Description: "Current memory utilization of the member",
+			},
+			"ipv4_address": {
+				Type:        schema.TypeString,
+				Description: "Public IPv4 address of the member Droplet",
+			},
+			"ipv4_address_private": {
+				Type:        schema.TypeString,
+				Description: "Private IPv4 address of the member Droplet",
+			},
+			"ipv6_address": {
+				Type:        schema.TypeString,
+				Description: "Public IPv6 address of the member Droplet",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Description: "Member create timestamp",
+			},
+			"updated_at": {
+				Type:        schema.TypeString,
+				Description: "Member update timestamp",
+			},
+		},
+		ResultAttributeName: "members",
+		FlattenRecord:       flattenDigitalOceanDropletAutoscaleMember,
+		GetRecords:          getDigitalOceanDropletAutoscaleMembers,
+		ExtraQuerySchema: map[string]*schema.Schema{
+			"autoscale_pool_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+				Description:  "ID of the Droplet autoscale pool",
+			},
+		},
+	}
+
+	return datalist.NewResource(dataListConfig)
+}
+
+func getDigitalOceanDropletAutoscaleMembers(meta interface{}, extra map[string]interface{}) ([]interface{}, error) {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	poolID := extra["autoscale_pool_id"].(string)
+
+	opts := &godo.ListOptions{
+		Page:    1,
+		PerPage: 200,
+	}
+
+	var memberList []interface{}
+
+	for {
+		members, resp, err := client.DropletAutoscale.ListMembers(context.Background(), poolID, opts)
+		if err != nil {
+			return nil, fmt.Errorf("Error listing Droplet autoscale pool (%s) members: %s", poolID, err)
+		}
+
+		for _, member := range members {
+			// Members may be removed by a scale-in between the two calls, in
+			// which case only the member details are returned.
+			d, dropletResp, err := client.Droplets.Get(context.Background(), int(member.DropletID))
+			if err != nil && (dropletResp == nil || dropletResp.StatusCode != http.StatusNotFound) {
+				return nil, fmt.Errorf("Error retrieving Droplet (%d) for autoscale pool (%s): %s", member.DropletID, poolID, err)
+			}
+
+			memberList = append(memberList, dropletAutoscaleMember{
+				member:  member,
+				droplet: d,
+			})
+		}
+
+		if resp.Links == nil || resp.Links.IsLastPage() {
+			break
+		}
+
+		page, err := resp.Links.CurrentPage()
+		if err != nil {
+			return nil, fmt.Errorf("Error listing Droplet autoscale pool (%s) members: %s", poolID, err)
+		}
+
+		opts.Page = page + 1
+	}
+
+	return memberList, nil
+}
+
+func flattenDigitalOceanDropletAutoscaleMember(rawMember, meta interface{}, extra map[string]interface{}) (map[string]interface{}, error) {
+	m := rawMember.(dropletAutoscaleMember)
+
+	flattened := map[string]interface{}{
+		"droplet_id":           int(m.member.DropletID),
+		"name":                 "",
+		"status":               m.member.Status,
+		"health_status":        m.member.HealthStatus,
+		"unhealthy_reason":     m.member.UnhealthyReason,
+		"cpu_utilization":      float64(0),
+		"memory_utilization":   float64(0),
+		"ipv4_address":         "",
+		"ipv4_address_private": "",
+		"ipv6_address":         "",
+		"created_at":           m.member.CreatedAt.UTC().String(),
+		"updated_at":           m.member.UpdatedAt.UTC().String(),
+	}
+
+	if m.member.CurrentUtilization != nil {
+		flattened["cpu_utilization"] = m.member.CurrentUtilization.CPU
+		flattened["memory_utilization"] = m.member.CurrentUtilization.Memory
+	}
+
+	if m.droplet != nil {
+		flattened["name"] = m.droplet.Name
+		flattened["ipv4_address"] = droplet.FindIPv4AddrByType(m.droplet, "public")
+		flattened["ipv4_address_private"] = droplet.FindIPv4AddrByType(m.droplet, "private")
+		flattened["ipv6_address"] = droplet.FindIPv6AddrByType(m.droplet, "public")
+	}
+
+	return flattened, nil
+}
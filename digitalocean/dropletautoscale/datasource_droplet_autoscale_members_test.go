@@ -0,0 +1,57 @@
+package dropletautoscale_test
+
+import (
+	"testing"
+
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceDigitalOceanDropletAutoscaleMembers_Basic(t *testing.T) {
+	name := acceptance.RandomTestName()
+
+	createConfig := testAccCheckDigitalOceanDropletAutoscaleConfig_static(name, 2)
+	dataSourceConfig := `
+data "digitalocean_droplet_autoscale_members" "foo" {
+  autoscale_pool_id = digitalocean_droplet_autoscale.foobar.id
+
+  sort {
+    key       = "droplet_id"
+    direction = "asc"
+  }
+}`
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanDropletAutoscaleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: createConfig,
+			},
+			{
+				Config: createConfig + dataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.digitalocean_droplet_autoscale_members.foo", "members.#", "2"),
+					resource.TestCheckResourceAttrSet(
+						"data.digitalocean_droplet_autoscale_members.foo", "members.0.droplet_id"),
+					resource.TestCheckResourceAttrSet(
+						"data.digitalocean_droplet_autoscale_members.foo", "members.0.name"),
+					resource.TestCheckResourceAttr(
+						"data.digitalocean_droplet_autoscale_members.foo", "members.0.status", "active"),
+					resource.TestCheckResourceAttrSet(
+						"data.digitalocean_droplet_autoscale_members.foo", "members.0.health_status"),
+					resource.TestCheckResourceAttrSet(
+						"data.digitalocean_droplet_autoscale_members.foo", "members.0.ipv4_address"),
+					resource.TestCheckResourceAttrSet(
+						"data.digitalocean_droplet_autoscale_members.foo", "members.0.ipv4_address_private"),
+					resource.TestCheckResourceAttrSet(
+						"data.digitalocean_droplet_autoscale_members.foo", "members.0.ipv6_address"),
+					resource.TestCheckResourceAttrSet(
+						"data.digitalocean_droplet_autoscale_members.foo", "members.0.created_at"),
+				),
+			},
+		},
+	})
+}
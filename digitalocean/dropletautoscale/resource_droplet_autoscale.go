@@ -3,7 +3,9 @@ package dropletautoscale
 import (
 	"context"
 	"fmt"
+	"log"
 	"net/http"
+	"slices"
 	"strings"
 	"time"
 
@@ -22,7 +24,11 @@ func ResourceDigitalOceanDropletAutoscale() *schema.Resource {
 		UpdateContext: resourceDigitalOceanDropletAutoscaleUpdate,
 		DeleteContext: resourceDigitalOceanDropletAutoscaleDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceDigitalOceanDropletAutoscaleImport,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(15 * time.Minute),
+			Update: schema.DefaultTimeout(15 * time.Minute),
 		},
 		Schema: map[string]*schema.Schema{
 			"id": {
@@ -147,6 +153,12 @@ func ResourceDigitalOceanDropletAutoscale() *schema.Resource {
 					},
 				},
 			},
+			"wait_for_capacity": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Wait for the Droplet autoscale pool to reach its minimum or target number of active members",
+			},
 			"current_utilization": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -246,6 +258,10 @@ func resourceDigitalOceanDropletAutoscaleCreate(ctx context.Context, d *schema.R
 	}
 	d.SetId(pool.ID)
 
+	// Both waits share the create timeout rather than each being given the
+	// full duration.
+	deadline := time.Now().Add(d.Timeout(schema.TimeoutCreate))
+
 	// Setup to poll for autoscale pool scaling up to the desired count
 	stateConf := &retry.StateChangeConf{
 		Delay:      5 * time.Second,
@@ -253,12 +269,18 @@ func resourceDigitalOceanDropletAutoscaleCreate(ctx context.Context, d *schema.R
 		Target:     []string{"active"},
 		Refresh:    dropletAutoscaleRefreshFunc(client, d.Id()),
 		MinTimeout: 15 * time.Second,
-		Timeout:    15 * time.Minute,
+		Timeout:    time.Until(deadline),
 	}
 	if _, err = stateConf.WaitForStateContext(ctx); err != nil {
 		return diag.Errorf("Error waiting for Droplet autoscale pool (%s) to become active: %v", pool.Name, err)
 	}
 
+	if d.Get("wait_for_capacity").(bool) {
+		if err = waitForDropletAutoscaleCapacity(ctx, client, d, time.Until(deadline)); err != nil {
+			return diag.Errorf("Error waiting for Droplet autoscale pool (%s) to reach capacity: %v", pool.Name, err)
+		}
+	}
+
 	return resourceDigitalOceanDropletAutoscaleRead(ctx, d, meta)
 }
 
@@ -299,18 +321,35 @@ func resourceDigitalOceanDropletAutoscaleRead(ctx context.Context, d *schema.Res
 func resourceDigitalOceanDropletAutoscaleUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	client := meta.(*config.CombinedConfig).GodoClient()
 
-	_, _, err := client.DropletAutoscale.Update(context.Background(), d.Id(), &godo.DropletAutoscalePoolRequest{
-		Name:            d.Get("name").(string),
-		Config:          expandConfig(d.Get("config").([]interface{})),
-		DropletTemplate: expandTemplate(d.Get("droplet_template").([]interface{})),
-	})
-	if err != nil {
-		return diag.Errorf("Error updating Droplet autoscale pool: %v", err)
+	// wait_for_capacity only affects Terraform, so changing it alone does not
+	// update the pool.
+	if d.HasChangeExcept("wait_for_capacity") {
+		_, _, err := client.DropletAutoscale.Update(context.Background(), d.Id(), &godo.DropletAutoscalePoolRequest{
+			Name:            d.Get("name").(string),
+			Config:          expandConfig(d.Get("config").([]interface{})),
+			DropletTemplate: expandTemplate(d.Get("droplet_template").([]interface{})),
+		})
+		if err != nil {
+			return diag.Errorf("Error updating Droplet autoscale pool: %v", err)
+		}
+	}
+
+	if d.Get("wait_for_capacity").(bool) && (d.HasChange("config") || d.HasChange("wait_for_capacity")) {
+		if err := waitForDropletAutoscaleCapacity(ctx, client, d, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.Errorf("Error waiting for Droplet autoscale pool (%s) to reach capacity: %v", d.Get("name"), err)
+		}
 	}
 
 	return resourceDigitalOceanDropletAutoscaleRead(ctx, d, meta)
 }
 
+// wait_for_capacity is not returned by the API, so it is set to its default.
+func resourceDigitalOceanDropletAutoscaleImport(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	d.Set("wait_for_capacity", false)
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceDigitalOceanDropletAutoscaleDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	client := meta.(*config.CombinedConfig).GodoClient()
 
@@ -349,26 +388,9 @@ func dropletAutoscaleRefreshFunc(client *godo.Client, poolID string) retry.State
 		if pool.Status != "active" {
 			return pool, pool.Status, nil
 		}
-		members := make([]*godo.DropletAutoscaleResource, 0)
-		opts := &godo.ListOptions{
-			Page:    1,
-			PerPage: 100,
-		}
-		// Paginate through autoscale pool members and validate status
-		for {
-			m, resp, err := client.DropletAutoscale.ListMembers(context.Background(), poolID, opts)
-			if err != nil {
-				return nil, "", fmt.Errorf("Error listing Droplet autoscale pool members: %v", err)
-			}
-			members = append(members, m...)
-			if resp.Links.IsLastPage() {
-				break
-			}
-			page, err := resp.Links.CurrentPage()
-			if err != nil {
-				break
-			}
-			opts.Page = page + 1
+		members, err := listDropletAutoscaleMembers(client, poolID)
+		if err != nil {
+			return nil, "", err
 		}
 		// Scan through the list to find a non-active provision state
 		for i := range members {
@@ -379,3 +401,103 @@ func dropletAutoscaleRefreshFunc(client *godo.Client, poolID string) retry.State
 		return members, "active", nil
 	}
 }
+
+func listDropletAutoscaleMembers(client *godo.Client, poolID string) ([]*godo.DropletAutoscaleResource, error) {
+	members := make([]*godo.DropletAutoscaleResource, 0)
+	opts := &godo.ListOptions{
+		Page:    1,
+		PerPage: 100,
+	}
+	// Paginate through autoscale pool members
+	for {
+		m, resp, err := client.DropletAutoscale.ListMembers(context.Background(), poolID, opts)
+		if err != nil {
+			return nil, fmt.Errorf("Error listing Droplet autoscale pool members: %v", err)
+		}
+		members = append(members, m...)
+		if resp.Links.IsLastPage() {
+			break
+		}
+		page, err := resp.Links.CurrentPage()
+		if err != nil {
+			break
+		}
+		opts.Page = page + 1
+	}
+	return members, nil
+}
+
+// dropletAutoscaleDesiredCapacity returns the number of active members a pool
+// is expected to converge to: the target for static pools, otherwise the
+// minimum for dynamic pools.
+func dropletAutoscaleDesiredCapacity(config *godo.DropletAutoscaleConfiguration) int {
+	if config == nil {
+		return 0
+	}
+	if config.TargetNumberInstances > 0 {
+		return int(config.TargetNumberInstances)
+	}
+	return int(config.MinInstances)
+}
+
+func waitForDropletAutoscaleCapacity(ctx context.Context, client *godo.Client, d *schema.ResourceData, timeout time.Duration) error {
+	desired := dropletAutoscaleDesiredCapacity(expandConfig(d.Get("config").([]interface{})))
+
+	stateConf := &retry.StateChangeConf{
+		Delay:      5 * time.Second,
+		Pending:    []string{"scaling"},
+		Target:     []string{"ready"},
+		Refresh:    dropletAutoscaleCapacityRefreshFunc(client, d.Id(), desired),
+		MinTimeout: 15 * time.Second,
+		Timeout:    timeout,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		// Surface the reasons recorded for failed scaling events, which
+		// usually explain why the pool is not converging.
+		if reasons := dropletAutoscaleHistoryErrors(client, d.Id()); len(reasons) > 0 {
+			return fmt.Errorf("%v; recent scaling errors: %s", err, strings.Join(reasons, "; "))
+		}
+		return err
+	}
+
+	return nil
+}
+
+func dropletAutoscaleCapacityRefreshFunc(client *godo.Client, poolID string, desired int) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		members, err := listDropletAutoscaleMembers(client, poolID)
+		if err != nil {
+			return nil, "", err
+		}
+		active := 0
+		for _, member := range members {
+			if member.Status == "active" {
+				active++
+			}
+		}
+		log.Printf("[DEBUG] Droplet autoscale pool (%s) has %d of %d active members", poolID, active, desired)
+		if active < desired {
+			return members, "scaling", nil
+		}
+		return members, "ready", nil
+	}
+}
+
+// dropletAutoscaleHistoryErrors returns the distinct error reasons from the
+// most recent page of the pool's scaling history.
+func dropletAutoscaleHistoryErrors(client *godo.Client, poolID string) []string {
+	events, _, err := client.DropletAutoscale.ListHistory(context.Background(), poolID, &godo.ListOptions{PerPage: 20})
+	if err != nil {
+		log.Printf("[WARN] Error listing Droplet autoscale pool (%s) history: %v", poolID, err)
+		return nil
+	}
+
+	var reasons []string
+	for _, event := range events {
+		if event.ErrorReason == "" || slices.Contains(reasons, event.ErrorReason) {
+			continue
+		}
+		reasons = append(reasons, event.ErrorReason)
+	}
+	return reasons
+}
@@ -0,0 +1,148 @@
+package dropletautoscale
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDropletAutoscaleDesiredCapacity(t *testing.T) {
+	assert.Equal(t, 0, dropletAutoscaleDesiredCapacity(nil))
+	assert.Equal(t, 3, dropletAutoscaleDesiredCapacity(&godo.DropletAutoscaleConfiguration{TargetNumberInstances: 3}))
+	assert.Equal(t, 2, dropletAutoscaleDesiredCapacity(&godo.DropletAutoscaleConfiguration{MinInstances: 2, MaxInstances: 5}))
+}
+
+func TestDropletAutoscaleCapacity(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	mux.HandleFunc("/v2/droplets/autoscale/pool-1/members", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{
+  "droplets": [
+    {"droplet_id": 1, "status": "active"},
+    {"droplet_id": 2, "status": "new"}
+  ],
+  "links": {},
+  "meta": {"total": 2}
+}`)
+	})
+
+	mux.HandleFunc("/v2/droplets/autoscale/pool-1/history", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{
+  "history": [
+    {"history_event_id": "a", "status": "error", "error_reason": "quota exceeded"},
+    {"history_event_id": "b", "status": "error", "error_reason": "quota exceeded"},
+    {"history_event_id": "c", "status": "success"}
+  ],
+  "links": {},
+  "meta": {"total": 3}
+}`)
+	})
+
+	cfg := &config.Config{
+		Token:       "test-token",
+		APIEndpoint: server.URL,
+	}
+	combined, err := cfg.Client()
+	if err != nil {
+		t.Fatalf("error building client: %s", err)
+	}
+	client := combined.GodoClient()
+
+	_, state, err := dropletAutoscaleCapacityRefreshFunc(client, "pool-1", 2)()
+	if err != nil {
+		t.Fatalf("refresh returned error: %s", err)
+	}
+	assert.Equal(t, "scaling", state)
+
+	_, state, err = dropletAutoscaleCapacityRefreshFunc(client, "pool-1", 1)()
+	if err != nil {
+		t.Fatalf("refresh returned error: %s", err)
+	}
+	assert.Equal(t, "ready", state)
+
+	assert.Equal(t, []string{"quota exceeded"}, dropletAutoscaleHistoryErrors(client, "pool-1"))
+}
+
+// wait_for_capacity is set on import and changing it alone does not update
+// the pool.
+func TestDropletAutoscaleWaitForCapacityOnly(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	mux.HandleFunc("/v2/droplets/autoscale/pool-1", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		fmt.Fprint(w, `{
+  "autoscale_pool": {
+    "id": "pool-1",
+    "name": "pool",
+    "config": {"target_number_instances": 1},
+    "droplet_template": {"size": "s-1vcpu-512mb-10gb", "region": "nyc3", "image": "ubuntu-24-04-x64", "ssh_keys": ["1"]},
+    "status": "active"
+  }
+}`)
+	})
+
+	cfg := &config.Config{
+		Token:       "test-token",
+		APIEndpoint: server.URL,
+	}
+	client, err := cfg.Client()
+	if err != nil {
+		t.Fatalf("error building client: %s", err)
+	}
+
+	r := ResourceDigitalOceanDropletAutoscale()
+	rawConfig := func(waitForCapacity bool) map[string]interface{} {
+		return map[string]interface{}{
+			"name": "pool",
+			"config": []interface{}{
+				map[string]interface{}{"target_number_instances": 1},
+			},
+			"droplet_template": []interface{}{
+				map[string]interface{}{
+					"size":     "s-1vcpu-512mb-10gb",
+					"region":   "nyc3",
+					"image":    "ubuntu-24-04-x64",
+					"ssh_keys": []interface{}{"1"},
+				},
+			},
+			"wait_for_capacity": waitForCapacity,
+		}
+	}
+
+	d := r.Data(&terraform.InstanceState{ID: "pool-1"})
+	imported, err := r.Importer.StateContext(context.Background(), d, client)
+	if err != nil {
+		t.Fatalf("import returned error: %s", err)
+	}
+	if diags := r.ReadContext(context.Background(), imported[0], client); diags.HasError() {
+		t.Fatalf("read returned error: %v", diags)
+	}
+	state := imported[0].State()
+	assert.Equal(t, "false", state.Attributes["wait_for_capacity"])
+
+	diff, err := r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(rawConfig(false)), client)
+	if err != nil {
+		t.Fatalf("diff returned error: %s", err)
+	}
+	assert.Nil(t, diff, "imported pool should have no diff")
+
+	state.Attributes["wait_for_capacity"] = "true"
+	diff, err = r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(rawConfig(false)), client)
+	if err != nil {
+		t.Fatalf("diff returned error: %s", err)
+	}
+	if _, diags := r.Apply(context.Background(), state, diff, client); diags.HasError() {
+		t.Fatalf("apply returned error: %v", diags)
+	}
+}
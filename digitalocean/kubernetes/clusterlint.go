@@ -0,0 +1,173 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/digitalocean/godo"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// Clusterlint severities ordered from least to most severe.
+var clusterlintSeverities = []string{"suggestion", "warning", "error"}
+
+func preflightClusterlintSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"fail_on_severity": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      "error",
+					ValidateFunc: validation.StringInSlice(clusterlintSeverities, false),
+				},
+				"include_groups": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				"exclude_groups": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				"include_checks": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				"exclude_checks": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+			},
+		},
+	}
+}
+
+func expandClusterlintRequest(raw map[string]interface{}) *godo.KubernetesRunClusterlintRequest {
+	expand := func(key string) []string {
+		set, ok := raw[key].(*schema.Set)
+		if !ok {
+			return nil
+		}
+		values := make([]string, 0, set.Len())
+		for _, v := range set.List() {
+			values = append(values, v.(string))
+		}
+		slices.Sort(values)
+		return values
+	}
+
+	return &godo.KubernetesRunClusterlintRequest{
+		IncludeGroups: expand("include_groups"),
+		ExcludeGroups: expand("exclude_groups"),
+		IncludeChecks: expand("include_checks"),
+		ExcludeChecks: expand("exclude_checks"),
+	}
+}
+
+// runKubernetesClusterlint starts a clusterlint run and waits for its results.
+func runKubernetesClusterlint(ctx context.Context, client *godo.Client, clusterID string, req *godo.KubernetesRunClusterlintRequest, timeout time.Duration) ([]*godo.ClusterlintDiagnostic, error) {
+	runID, _, err := client.Kubernetes.RunClusterlint(ctx, clusterID, req)
+	if err != nil {
+		return nil, fmt.Errorf("Error running clusterlint for Kubernetes cluster (%s): %s", clusterID, err)
+	}
+
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{"running"},
+		Target:     []string{"complete"},
+		Refresh:    kubernetesClusterlintRefreshFunc(ctx, client, clusterID, runID),
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+		Timeout:    timeout,
+	}
+
+	result, err := stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("Error waiting for clusterlint run (%s) for Kubernetes cluster (%s): %s", runID, clusterID, err)
+	}
+
+	return result.([]*godo.ClusterlintDiagnostic), nil
+}
+
+func kubernetesClusterlintRefreshFunc(ctx context.Context, client *godo.Client, clusterID, runID string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		diagnostics, resp, err := client.Kubernetes.GetClusterlintResults(ctx, clusterID, &godo.KubernetesGetClusterlintRequest{
+			RunId: runID,
+		})
+		if err != nil {
+			// Results are not available until the run completes.
+			if resp != nil && resp.StatusCode == http.StatusNotFound {
+				return runID, "running", nil
+			}
+			return nil, "", err
+		}
+
+		if diagnostics == nil {
+			diagnostics = []*godo.ClusterlintDiagnostic{}
+		}
+
+		return diagnostics, "complete", nil
+	}
+}
+
+// filterClusterlintDiagnostics returns the diagnostics at or above the given
+// severity.
+func filterClusterlintDiagnostics(diagnostics []*godo.ClusterlintDiagnostic, severity string) []*godo.ClusterlintDiagnostic {
+	threshold := slices.Index(clusterlintSeverities, severity)
+
+	var filtered []*godo.ClusterlintDiagnostic
+	for _, diagnostic := range diagnostics {
+		if slices.Index(clusterlintSeverities, diagnostic.Severity) >= threshold {
+			filtered = append(filtered, diagnostic)
+		}
+	}
+
+	return filtered
+}
+
+func formatClusterlintDiagnostic(diagnostic *godo.ClusterlintDiagnostic) string {
+	object := ""
+	if diagnostic.Object != nil {
+		object = diagnostic.Object.Kind + "/" + diagnostic.Object.Name
+		if diagnostic.Object.Namespace != "" {
+			object = diagnostic.Object.Namespace + "/" + object
+		}
+	}
+
+	return fmt.Sprintf("[%s] %s %s: %s", diagnostic.Severity, diagnostic.CheckName, object, diagnostic.Message)
+}
+
+// preflightKubernetesClusterlint runs clusterlint with the given settings and
+// returns an error if any diagnostics at or above the configured severity are
+// found.
+func preflightKubernetesClusterlint(ctx context.Context, client *godo.Client, clusterID string, raw map[string]interface{}, timeout time.Duration) error {
+	diagnostics, err := runKubernetesClusterlint(ctx, client, clusterID, expandClusterlintRequest(raw), timeout)
+	if err != nil {
+		return err
+	}
+
+	severity := raw["fail_on_severity"].(string)
+	findings := filterClusterlintDiagnostics(diagnostics, severity)
+	if len(findings) == 0 {
+		return nil
+	}
+
+	messages := make([]string, 0, len(findings))
+	for _, finding := range findings {
+		messages = append(messages, formatClusterlintDiagnostic(finding))
+	}
+
+	return fmt.Errorf("clusterlint found %d issue(s) with severity %s or higher:\n%s", len(findings), severity, strings.Join(messages, "\n"))
+}
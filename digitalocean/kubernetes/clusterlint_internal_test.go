@@ -0,0 +1,98 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFilterClusterlintDiagnostics(t *testing.T) {
+	diagnostics := []*godo.ClusterlintDiagnostic{
+		{CheckName: "bare-pods", Severity: "warning"},
+		{CheckName: "deprecated-apis", Severity: "error"},
+		{CheckName: "latest-tag", Severity: "suggestion"},
+	}
+
+	assert.Len(t, filterClusterlintDiagnostics(diagnostics, "error"), 1)
+	assert.Len(t, filterClusterlintDiagnostics(diagnostics, "warning"), 2)
+	assert.Len(t, filterClusterlintDiagnostics(diagnostics, "suggestion"), 3)
+	assert.Empty(t, filterClusterlintDiagnostics(nil, "suggestion"))
+}
+
+func TestFormatClusterlintDiagnostic(t *testing.T) {
+	assert.Equal(t, "[error] deprecated-apis kube-system/Deployment/foo: uses a removed API",
+		formatClusterlintDiagnostic(&godo.ClusterlintDiagnostic{
+			CheckName: "deprecated-apis",
+			Severity:  "error",
+			Message:   "uses a removed API",
+			Object: &godo.ClusterlintObject{
+				Kind:      "Deployment",
+				Name:      "foo",
+				Namespace: "kube-system",
+			},
+		}))
+}
+
+func TestExpandClusterlintRequest(t *testing.T) {
+	req := expandClusterlintRequest(map[string]interface{}{
+		"fail_on_severity": "error",
+		"include_groups":   schema.NewSet(schema.HashString, []interface{}{"doks", "basic"}),
+		"exclude_checks":   schema.NewSet(schema.HashString, []interface{}{"bare-pods"}),
+	})
+
+	assert.Equal(t, []string{"basic", "doks"}, req.IncludeGroups)
+	assert.Equal(t, []string{"bare-pods"}, req.ExcludeChecks)
+	assert.Nil(t, req.ExcludeGroups)
+	assert.Nil(t, req.IncludeChecks)
+}
+
+func TestKubernetesClusterlintRefreshFunc(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	complete := false
+	mux.HandleFunc("/v2/kubernetes/clusters/cluster-1/clusterlint", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "run-1", r.URL.Query().Get("run_id"))
+		if !complete {
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"id": "not_found", "message": "run not complete"}`)
+			return
+		}
+		fmt.Fprint(w, `{"diagnostics": [{"check_name": "bare-pods", "severity": "warning", "message": "bare pod", "object": {"kind": "Pod", "name": "foo", "namespace": "default"}}]}`)
+	})
+
+	cfg := &config.Config{
+		Token:       "test-token",
+		APIEndpoint: server.URL,
+	}
+	combined, err := cfg.Client()
+	if err != nil {
+		t.Fatalf("error building client: %s", err)
+	}
+	refresh := kubernetesClusterlintRefreshFunc(context.Background(), combined.GodoClient(), "cluster-1", "run-1")
+
+	result, state, err := refresh()
+	if err != nil {
+		t.Fatalf("refresh returned error: %s", err)
+	}
+	assert.Equal(t, "running", state)
+	assert.NotNil(t, result)
+
+	complete = true
+	result, state, err = refresh()
+	if err != nil {
+		t.Fatalf("refresh returned error: %s", err)
+	}
+	assert.Equal(t, "complete", state)
+	diagnostics := result.([]*godo.ClusterlintDiagnostic)
+	assert.Len(t, diagnostics, 1)
+	assert.Equal(t, "bare-pods", diagnostics[0].CheckName)
+}
@@ -0,0 +1,123 @@
+package kubernetes
+
+import (
+	"context"
+	"encoding/base64"
+	"time"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func DataSourceDigitalOceanKubernetesClusterCredentials() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceDigitalOceanKubernetesClusterCredentialsRead,
+		Schema: map[string]*schema.Schema{
+			"cluster_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"expiry_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"host": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"cluster_ca_certificate": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"client_certificate": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"client_key": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"token": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"expires_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"raw_config": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"user_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"username": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"groups": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceDigitalOceanKubernetesClusterCredentialsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	clusterID := d.Get("cluster_id").(string)
+
+	cluster, _, err := client.Kubernetes.Get(ctx, clusterID)
+	if err != nil {
+		return diag.Errorf("Error retrieving Kubernetes cluster (%s): %s", clusterID, err)
+	}
+
+	req := &godo.KubernetesClusterCredentialsGetRequest{}
+	if v, ok := d.GetOk("expiry_seconds"); ok {
+		req.ExpirySeconds = godo.PtrTo(v.(int))
+	}
+
+	creds, _, err := client.Kubernetes.GetCredentials(ctx, clusterID, req)
+	if err != nil {
+		return diag.Errorf("Error retrieving credentials for Kubernetes cluster (%s): %s", clusterID, err)
+	}
+
+	user, _, err := client.Kubernetes.GetUser(ctx, clusterID)
+	if err != nil {
+		return diag.Errorf("Error retrieving user for Kubernetes cluster (%s): %s", clusterID, err)
+	}
+
+	rawConfig, err := RenderKubeconfig(cluster.Name, cluster.RegionSlug, creds)
+	if err != nil {
+		return diag.Errorf("Error rendering kubeconfig for Kubernetes cluster (%s): %s", clusterID, err)
+	}
+
+	d.SetId(clusterID)
+	d.Set("host", creds.Server)
+	d.Set("cluster_ca_certificate", base64.StdEncoding.EncodeToString(creds.CertificateAuthorityData))
+	d.Set("client_certificate", string(creds.ClientCertificateData))
+	d.Set("client_key", string(creds.ClientKeyData))
+	d.Set("token", creds.Token)
+	d.Set("expires_at", creds.ExpiresAt.Format(time.RFC3339))
+	d.Set("raw_config", string(rawConfig))
+
+	if user != nil {
+		d.Set("user_id", user.ID)
+		d.Set("username", user.Username)
+		d.Set("groups", user.Groups)
+	}
+
+	return nil
+}
@@ -0,0 +1,55 @@
+package kubernetes_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceDigitalOceanKubernetesClusterCredentials_Basic(t *testing.T) {
+	rName := acceptance.RandomTestName()
+	var k8s godo.KubernetesCluster
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanKubernetesClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`%s
+
+resource "digitalocean_kubernetes_cluster" "foobar" {
+  name    = "%s"
+  region  = "nyc1"
+  version = data.digitalocean_kubernetes_versions.test.latest_version
+
+  node_pool {
+    name       = "default"
+    size       = "s-1vcpu-2gb"
+    node_count = 1
+  }
+}
+
+data "digitalocean_kubernetes_cluster_credentials" "foobar" {
+  cluster_id     = digitalocean_kubernetes_cluster.foobar.id
+  expiry_seconds = 3600
+}
+`, testClusterVersionLatest, rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDigitalOceanKubernetesClusterExists("digitalocean_kubernetes_cluster.foobar", &k8s),
+					resource.TestCheckResourceAttrPair(
+						"data.digitalocean_kubernetes_cluster_credentials.foobar", "host",
+						"digitalocean_kubernetes_cluster.foobar", "endpoint"),
+					resource.TestCheckResourceAttrSet("data.digitalocean_kubernetes_cluster_credentials.foobar", "token"),
+					resource.TestCheckResourceAttrSet("data.digitalocean_kubernetes_cluster_credentials.foobar", "cluster_ca_certificate"),
+					resource.TestCheckResourceAttrSet("data.digitalocean_kubernetes_cluster_credentials.foobar", "expires_at"),
+					resource.TestCheckResourceAttrSet("data.digitalocean_kubernetes_cluster_credentials.foobar", "raw_config"),
+					resource.TestCheckResourceAttrSet("data.digitalocean_kubernetes_cluster_credentials.foobar", "username"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,131 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func DataSourceDigitalOceanKubernetesClusterStatus() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceDigitalOceanKubernetesClusterStatusRead,
+		Schema: map[string]*schema.Schema{
+			"cluster_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"since": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"message": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"messages": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"message": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"timestamp": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceDigitalOceanKubernetesClusterStatusRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	clusterID := d.Get("cluster_id").(string)
+
+	cluster, _, err := client.Kubernetes.Get(ctx, clusterID)
+	if err != nil {
+		return diag.Errorf("Error retrieving Kubernetes cluster (%s): %s", clusterID, err)
+	}
+
+	req := &godo.KubernetesGetClusterStatusMessagesRequest{}
+	if v, ok := d.GetOk("since"); ok {
+		since, err := time.Parse(time.RFC3339, v.(string))
+		if err != nil {
+			return diag.Errorf("Error parsing since: %s", err)
+		}
+		req.Since = &since
+	}
+
+	messages, _, err := client.Kubernetes.GetClusterStatusMessages(ctx, clusterID, req)
+	if err != nil {
+		return diag.Errorf("Error retrieving status messages for Kubernetes cluster (%s): %s", clusterID, err)
+	}
+
+	d.SetId(clusterID)
+
+	if cluster.Status != nil {
+		d.Set("status", cluster.Status.State)
+		d.Set("message", cluster.Status.Message)
+	}
+
+	if err := d.Set("messages", flattenKubernetesClusterStatusMessages(messages)); err != nil {
+		return diag.Errorf("Error setting messages: %s", err)
+	}
+
+	return nil
+}
+
+func flattenKubernetesClusterStatusMessages(messages []*godo.KubernetesClusterStatusMessage) []map[string]interface{} {
+	flattened := make([]map[string]interface{}, 0, len(messages))
+	for _, m := range messages {
+		flattened = append(flattened, map[string]interface{}{
+			"message":   m.Message,
+			"timestamp": m.Timestamp.UTC().Format(time.RFC3339),
+		})
+	}
+
+	return flattened
+}
+
+// withKubernetesClusterStatusMessages appends the control plane status
+// messages recorded since the given time to err, as they usually explain why
+// a cluster failed to become ready.
+func withKubernetesClusterStatusMessages(client *godo.Client, clusterID string, since time.Time, err error) error {
+	messages, _, msgErr := client.Kubernetes.GetClusterStatusMessages(context.Background(), clusterID, &godo.KubernetesGetClusterStatusMessagesRequest{
+		Since: &since,
+	})
+	if msgErr != nil {
+		log.Printf("[WARN] Error retrieving status messages for Kubernetes cluster (%s): %s", clusterID, msgErr)
+		return err
+	}
+
+	if len(messages) == 0 {
+		return err
+	}
+
+	lines := make([]string, 0, len(messages))
+	for _, m := range messages {
+		lines = append(lines, fmt.Sprintf("%s: %s", m.Timestamp.UTC().Format(time.RFC3339), m.Message))
+	}
+
+	return fmt.Errorf("%w\n\nCluster status messages:\n%s", err, strings.Join(lines, "\n"))
+}
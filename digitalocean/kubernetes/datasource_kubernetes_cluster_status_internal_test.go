@@ -0,0 +1,47 @@
+package kubernetes
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithKubernetesClusterStatusMessages(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	since := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	mux.HandleFunc("/v2/kubernetes/clusters/cluster-1/status_messages", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, since.Format(time.RFC3339), r.URL.Query().Get("since"))
+		fmt.Fprint(w, `{"messages": [{"message": "control plane unhealthy", "timestamp": "2024-06-01T12:05:00Z"}]}`)
+	})
+	mux.HandleFunc("/v2/kubernetes/clusters/cluster-2/status_messages", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"messages": []}`)
+	})
+
+	cfg := &config.Config{
+		Token:       "test-token",
+		APIEndpoint: server.URL,
+	}
+	combined, err := cfg.Client()
+	if err != nil {
+		t.Fatalf("error building client: %s", err)
+	}
+	client := combined.GodoClient()
+
+	timeout := errors.New("Timeout waiting to create cluster")
+
+	err = withKubernetesClusterStatusMessages(client, "cluster-1", since, timeout)
+	assert.ErrorIs(t, err, timeout)
+	assert.Contains(t, err.Error(), "2024-06-01T12:05:00Z: control plane unhealthy")
+
+	err = withKubernetesClusterStatusMessages(client, "cluster-2", since, timeout)
+	assert.Equal(t, timeout, err)
+}
@@ -0,0 +1,113 @@
+package kubernetes
+
+import (
+	"context"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func DataSourceDigitalOceanKubernetesClusterUpgrades() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceDigitalOceanKubernetesClusterUpgradesRead,
+		Schema: map[string]*schema.Schema{
+			"cluster_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"latest_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"valid_versions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"available_upgrades": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"slug": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"kubernetes_version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"supported_features": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceDigitalOceanKubernetesClusterUpgradesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	clusterID := d.Get("cluster_id").(string)
+
+	upgrades, _, err := client.Kubernetes.GetUpgrades(ctx, clusterID)
+	if err != nil {
+		return diag.Errorf("Error retrieving available upgrades for Kubernetes cluster (%s): %s", clusterID, err)
+	}
+
+	d.SetId(clusterID)
+
+	validVersions := make([]string, 0, len(upgrades))
+	availableUpgrades := make([]map[string]interface{}, 0, len(upgrades))
+	for _, v := range upgrades {
+		validVersions = append(validVersions, v.Slug)
+		availableUpgrades = append(availableUpgrades, map[string]interface{}{
+			"slug":               v.Slug,
+			"kubernetes_version": v.KubernetesVersion,
+			"supported_features": v.SupportedFeatures,
+		})
+	}
+
+	d.Set("valid_versions", validVersions)
+	d.Set("latest_version", latestKubernetesVersion(upgrades))
+
+	if err := d.Set("available_upgrades", availableUpgrades); err != nil {
+		return diag.Errorf("Error setting available_upgrades: %s", err)
+	}
+
+	return nil
+}
+
+// latestKubernetesVersion returns the slug of the newest version in the list.
+// Slugs which cannot be parsed are ignored unless none can be parsed.
+func latestKubernetesVersion(versions []*godo.KubernetesVersion) string {
+	var (
+		latest    string
+		latestVer *version.Version
+	)
+	for _, v := range versions {
+		parsed, err := version.NewVersion(v.Slug)
+		if err != nil {
+			if latest == "" {
+				latest = v.Slug
+			}
+			continue
+		}
+
+		if latestVer == nil || parsed.GreaterThan(latestVer) {
+			latest = v.Slug
+			latestVer = parsed
+		}
+	}
+
+	return latest
+}
@@ -0,0 +1,68 @@
+package kubernetes_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceDigitalOceanKubernetesClusterUpgrades_Basic(t *testing.T) {
+	rName := acceptance.RandomTestName()
+	var k8s godo.KubernetesCluster
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanKubernetesClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDigitalOceanKubernetesConfigUpgradeGuard(testClusterVersionPrevious, rName,
+					"data.digitalocean_kubernetes_versions.test.latest_version"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDigitalOceanKubernetesClusterExists("digitalocean_kubernetes_cluster.foobar", &k8s),
+					resource.TestCheckResourceAttrSet(
+						"data.digitalocean_kubernetes_cluster_upgrades.foobar", "latest_version"),
+					resource.TestCheckResourceAttrSet(
+						"data.digitalocean_kubernetes_cluster_upgrades.foobar", "valid_versions.0"),
+					resource.TestCheckResourceAttrSet(
+						"data.digitalocean_kubernetes_cluster_upgrades.foobar", "available_upgrades.0.slug"),
+					resource.TestCheckResourceAttrSet(
+						"data.digitalocean_kubernetes_cluster_upgrades.foobar", "available_upgrades.0.kubernetes_version"),
+				),
+			},
+			{
+				// The guard refuses versions that are not available upgrades.
+				Config: testAccDigitalOceanKubernetesConfigUpgradeGuard(testClusterVersionPrevious, rName,
+					`"9.99.0-do.0"`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("available upgrades are"),
+			},
+		},
+	})
+}
+
+func testAccDigitalOceanKubernetesConfigUpgradeGuard(testClusterVersion string, rName string, clusterVersion string) string {
+	return fmt.Sprintf(`%s
+
+resource "digitalocean_kubernetes_cluster" "foobar" {
+  name                   = "%s"
+  region                 = "nyc1"
+  version                = %s
+  max_minor_version_skip = 1
+
+  node_pool {
+    name       = "default"
+    size       = "s-1vcpu-2gb"
+    node_count = 1
+  }
+}
+
+data "digitalocean_kubernetes_cluster_upgrades" "foobar" {
+  cluster_id = digitalocean_kubernetes_cluster.foobar.id
+}
+`, testClusterVersion, rName, clusterVersion)
+}
@@ -0,0 +1,108 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/digitalocean/terraform-provider-digitalocean/internal/datalist"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func DataSourceDigitalOceanKubernetesClusterlint() *schema.Resource {
+	dataListConfig := &datalist.ResourceConfig{
+		RecordSchema: map[string]*schema.Schema{
+			"check_name": {
+				Type:        schema.TypeString,
+				Description: "name of the clusterlint check",
+			},
+			"severity": {
+				Type:        schema.TypeString,
+				Description: "severity of the diagnostic",
+			},
+			"message": {
+				Type:        schema.TypeString,
+				Description: "description of the issue",
+			},
+			"object_kind": {
+				Type:        schema.TypeString,
+				Description: "kind of the Kubernetes object the diagnostic refers to",
+			},
+			"object_name": {
+				Type:        schema.TypeString,
+				Description: "name of the Kubernetes object the diagnostic refers to",
+			},
+			"object_namespace": {
+				Type:        schema.TypeString,
+				Description: "namespace of the Kubernetes object the diagnostic refers to",
+			},
+		},
+		ResultAttributeName: "diagnostics",
+		FlattenRecord:       flattenDigitalOceanKubernetesClusterlintDiagnostic,
+		GetRecords:          getDigitalOceanKubernetesClusterlintDiagnostics,
+		ExtraQuerySchema: map[string]*schema.Schema{
+			"cluster_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"run_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+		},
+	}
+
+	return datalist.NewResource(dataListConfig)
+}
+
+func getDigitalOceanKubernetesClusterlintDiagnostics(meta interface{}, extra map[string]interface{}) ([]interface{}, error) {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	clusterID := extra["cluster_id"].(string)
+	runID := extra["run_id"].(string)
+
+	// Without a run ID, the results of the latest run are returned. Runs are
+	// not started here, as reading a data source must not change the cluster.
+	diagnostics, resp, err := client.Kubernetes.GetClusterlintResults(context.Background(), clusterID, &godo.KubernetesGetClusterlintRequest{
+		RunId: runID,
+	})
+	if err != nil {
+		if runID == "" && resp != nil && resp.StatusCode == http.StatusNotFound {
+			return nil, fmt.Errorf("Error retrieving clusterlint results for Kubernetes cluster (%s): no completed clusterlint run was found", clusterID)
+		}
+		return nil, fmt.Errorf("Error retrieving clusterlint results for Kubernetes cluster (%s): %s", clusterID, err)
+	}
+
+	diagnosticList := make([]interface{}, 0, len(diagnostics))
+	for _, diagnostic := range diagnostics {
+		diagnosticList = append(diagnosticList, diagnostic)
+	}
+
+	return diagnosticList, nil
+}
+
+func flattenDigitalOceanKubernetesClusterlintDiagnostic(rawDiagnostic, meta interface{}, extra map[string]interface{}) (map[string]interface{}, error) {
+	diagnostic := rawDiagnostic.(*godo.ClusterlintDiagnostic)
+
+	flattened := map[string]interface{}{
+		"check_name":       diagnostic.CheckName,
+		"severity":         diagnostic.Severity,
+		"message":          diagnostic.Message,
+		"object_kind":      "",
+		"object_name":      "",
+		"object_namespace": "",
+	}
+
+	if diagnostic.Object != nil {
+		flattened["object_kind"] = diagnostic.Object.Kind
+		flattened["object_name"] = diagnostic.Object.Name
+		flattened["object_namespace"] = diagnostic.Object.Namespace
+	}
+
+	return flattened, nil
+}
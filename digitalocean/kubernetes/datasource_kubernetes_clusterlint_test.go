@@ -0,0 +1,98 @@
+package kubernetes_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/acceptance"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceDigitalOceanKubernetesClusterlint_Basic(t *testing.T) {
+	rName := acceptance.RandomTestName()
+	var k8s godo.KubernetesCluster
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanKubernetesClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDigitalOceanKubernetesConfigClusterlintCluster(testClusterVersionLatest, rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDigitalOceanKubernetesClusterExists("digitalocean_kubernetes_cluster.foobar", &k8s),
+				),
+			},
+			{
+				// The data source only reads results, so a run is started first.
+				PreConfig: func() { testAccRunDigitalOceanKubernetesClusterlint(t, &k8s) },
+				Config:    testAccDigitalOceanKubernetesConfigClusterlint(testClusterVersionLatest, rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(
+						"data.digitalocean_kubernetes_clusterlint.foobar", "diagnostics.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRunDigitalOceanKubernetesClusterlint(t *testing.T, k8s *godo.KubernetesCluster) {
+	client := acceptance.TestAccProvider.Meta().(*config.CombinedConfig).GodoClient()
+
+	runID, _, err := client.Kubernetes.RunClusterlint(context.Background(), k8s.ID, &godo.KubernetesRunClusterlintRequest{})
+	if err != nil {
+		t.Fatalf("Error running clusterlint: %s", err)
+	}
+
+	for i := 0; i < 60; i++ {
+		_, resp, err := client.Kubernetes.GetClusterlintResults(context.Background(), k8s.ID, &godo.KubernetesGetClusterlintRequest{RunId: runID})
+		if err == nil {
+			return
+		}
+		if resp == nil || resp.StatusCode != http.StatusNotFound {
+			t.Fatalf("Error retrieving clusterlint results: %s", err)
+		}
+		time.Sleep(10 * time.Second)
+	}
+
+	t.Fatalf("Timeout waiting for clusterlint run (%s) to complete", runID)
+}
+
+func testAccDigitalOceanKubernetesConfigClusterlintCluster(testClusterVersion string, rName string) string {
+	return fmt.Sprintf(`%s
+
+resource "digitalocean_kubernetes_cluster" "foobar" {
+  name    = "%s"
+  region  = "nyc1"
+  version = data.digitalocean_kubernetes_versions.test.latest_version
+
+  preflight_clusterlint {
+    fail_on_severity = "error"
+  }
+
+  node_pool {
+    name       = "default"
+    size       = "s-1vcpu-2gb"
+    node_count = 1
+  }
+}
+`, testClusterVersion, rName)
+}
+
+func testAccDigitalOceanKubernetesConfigClusterlint(testClusterVersion string, rName string) string {
+	return testAccDigitalOceanKubernetesConfigClusterlintCluster(testClusterVersion, rName) + `
+data "digitalocean_kubernetes_clusterlint" "foobar" {
+  cluster_id = digitalocean_kubernetes_cluster.foobar.id
+
+  filter {
+    key    = "severity"
+    values = ["error", "warning"]
+  }
+}
+`
+}
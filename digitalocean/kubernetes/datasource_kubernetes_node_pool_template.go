@@ -0,0 +1,165 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func DataSourceDigitalOceanKubernetesNodePoolTemplate() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceDigitalOceanKubernetesNodePoolTemplateRead,
+		Schema: map[string]*schema.Schema{
+			"cluster_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"node_pool_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"size": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"labels": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"taints": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"capacity":    nodePoolTemplateResourcesSchema(),
+			"allocatable": nodePoolTemplateResourcesSchema(),
+			"gpu": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"vendor": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"model": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func nodePoolTemplateResourcesSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"cpu_milli_cores": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"memory": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"pods": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceDigitalOceanKubernetesNodePoolTemplateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	clusterID := d.Get("cluster_id").(string)
+	poolName := d.Get("node_pool_name").(string)
+
+	pool, _, err := client.Kubernetes.GetNodePoolTemplate(ctx, clusterID, poolName)
+	if err != nil {
+		return diag.Errorf("Error retrieving template for node pool (%s) in Kubernetes cluster (%s): %s", poolName, clusterID, err)
+	}
+
+	if pool == nil || pool.Template == nil {
+		return diag.Errorf("No template found for node pool (%s) in Kubernetes cluster (%s)", poolName, clusterID)
+	}
+	template := pool.Template
+
+	d.SetId(fmt.Sprintf("%s/%s", clusterID, poolName))
+	d.Set("name", template.Name)
+	d.Set("size", template.Slug)
+	d.Set("labels", template.Labels)
+	d.Set("taints", template.Taints)
+
+	if err := d.Set("capacity", flattenNodePoolTemplateResources(template.Capacity)); err != nil {
+		return diag.Errorf("Error setting capacity: %s", err)
+	}
+
+	if err := d.Set("allocatable", flattenNodePoolTemplateResources(template.Allocatable)); err != nil {
+		return diag.Errorf("Error setting allocatable: %s", err)
+	}
+
+	if err := d.Set("gpu", flattenNodePoolTemplateGPU(template.Gpu)); err != nil {
+		return diag.Errorf("Error setting gpu: %s", err)
+	}
+
+	return nil
+}
+
+func flattenNodePoolTemplateResources(resources *godo.KubernetesNodePoolResources) []map[string]interface{} {
+	if resources == nil {
+		return nil
+	}
+
+	// CPU is deprecated in favor of CpuMilliCores but may be the only value
+	// reported by older clusters.
+	cpuMilliCores := resources.CpuMilliCores
+	if cpuMilliCores == 0 {
+		cpuMilliCores = resources.CPU * 1000
+	}
+
+	return []map[string]interface{}{
+		{
+			"cpu_milli_cores": int(cpuMilliCores),
+			"memory":          resources.Memory,
+			"pods":            int(resources.Pods),
+		},
+	}
+}
+
+func flattenNodePoolTemplateGPU(gpu *godo.KubernetesNodePoolGPUResources) []map[string]interface{} {
+	if gpu == nil {
+		return nil
+	}
+
+	return []map[string]interface{}{
+		{
+			"vendor": gpu.Vendor,
+			"model":  gpu.Model,
+			"count":  int(gpu.Count),
+		},
+	}
+}
@@ -0,0 +1,29 @@
+package kubernetes
+
+import (
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFlattenNodePoolTemplateResources(t *testing.T) {
+	assert.Nil(t, flattenNodePoolTemplateResources(nil))
+
+	assert.Equal(t, []map[string]interface{}{
+		{"cpu_milli_cores": 1900, "memory": "3Gi", "pods": 110},
+	}, flattenNodePoolTemplateResources(&godo.KubernetesNodePoolResources{
+		CpuMilliCores: 1900,
+		Memory:        "3Gi",
+		Pods:          110,
+	}))
+
+	// Fall back to the deprecated CPU field.
+	assert.Equal(t, []map[string]interface{}{
+		{"cpu_milli_cores": 2000, "memory": "4Gi", "pods": 110},
+	}, flattenNodePoolTemplateResources(&godo.KubernetesNodePoolResources{
+		CPU:    2,
+		Memory: "4Gi",
+		Pods:   110,
+	}))
+}
@@ -0,0 +1,60 @@
+package kubernetes_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceDigitalOceanKubernetesNodePoolTemplate_Basic(t *testing.T) {
+	rName := acceptance.RandomTestName()
+	var k8s godo.KubernetesCluster
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanKubernetesClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`%s
+
+resource "digitalocean_kubernetes_cluster" "foobar" {
+  name    = "%s"
+  region  = "nyc1"
+  version = data.digitalocean_kubernetes_versions.test.latest_version
+
+  node_pool {
+    name       = "default"
+    size       = "s-1vcpu-2gb"
+    node_count = 1
+    labels = {
+      priority = "high"
+    }
+  }
+}
+
+data "digitalocean_kubernetes_node_pool_template" "foobar" {
+  cluster_id     = digitalocean_kubernetes_cluster.foobar.id
+  node_pool_name = digitalocean_kubernetes_cluster.foobar.node_pool[0].name
+}
+`, testClusterVersionLatest, rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDigitalOceanKubernetesClusterExists("digitalocean_kubernetes_cluster.foobar", &k8s),
+					resource.TestCheckResourceAttr(
+						"data.digitalocean_kubernetes_node_pool_template.foobar", "size", "s-1vcpu-2gb"),
+					resource.TestCheckResourceAttr(
+						"data.digitalocean_kubernetes_node_pool_template.foobar", "labels.priority", "high"),
+					resource.TestCheckResourceAttrSet(
+						"data.digitalocean_kubernetes_node_pool_template.foobar", "allocatable.0.cpu_milli_cores"),
+					resource.TestCheckResourceAttrSet(
+						"data.digitalocean_kubernetes_node_pool_template.foobar", "allocatable.0.memory"),
+					resource.TestCheckResourceAttrSet(
+						"data.digitalocean_kubernetes_node_pool_template.foobar", "capacity.0.pods"),
+				),
+			},
+		},
+	})
+}
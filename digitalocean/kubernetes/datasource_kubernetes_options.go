@@ -0,0 +1,110 @@
+package kubernetes
+
+import (
+	"context"
+
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceDigitalOceanKubernetesOptions() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceDigitalOceanKubernetesOptionsRead,
+		Schema: map[string]*schema.Schema{
+			"versions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"slug": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"kubernetes_version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"supported_features": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"regions": kubernetesOptionsSlugSchema(),
+			"sizes":   kubernetesOptionsSlugSchema(),
+		},
+	}
+}
+
+func kubernetesOptionsSlugSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"slug": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceDigitalOceanKubernetesOptionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	options, _, err := client.Kubernetes.GetOptions(ctx)
+	if err != nil {
+		return diag.Errorf("Error retrieving Kubernetes options: %s", err)
+	}
+
+	d.SetId(id.UniqueId())
+
+	versions := make([]map[string]interface{}, 0, len(options.Versions))
+	for _, v := range options.Versions {
+		versions = append(versions, map[string]interface{}{
+			"slug":               v.Slug,
+			"kubernetes_version": v.KubernetesVersion,
+			"supported_features": v.SupportedFeatures,
+		})
+	}
+
+	regions := make([]map[string]interface{}, 0, len(options.Regions))
+	for _, r := range options.Regions {
+		regions = append(regions, map[string]interface{}{
+			"name": r.Name,
+			"slug": r.Slug,
+		})
+	}
+
+	sizes := make([]map[string]interface{}, 0, len(options.Sizes))
+	for _, s := range options.Sizes {
+		sizes = append(sizes, map[string]interface{}{
+			"name": s.Name,
+			"slug": s.Slug,
+		})
+	}
+
+	if err := d.Set("versions", versions); err != nil {
+		return diag.Errorf("Error setting versions: %s", err)
+	}
+
+	if err := d.Set("regions", regions); err != nil {
+		return diag.Errorf("Error setting regions: %s", err)
+	}
+
+	if err := d.Set("sizes", sizes); err != nil {
+		return diag.Errorf("Error setting sizes: %s", err)
+	}
+
+	return nil
+}
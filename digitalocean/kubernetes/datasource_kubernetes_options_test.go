@@ -0,0 +1,26 @@
+package kubernetes_test
+
+import (
+	"testing"
+
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceDigitalOceanKubernetesOptions_Basic(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `data "digitalocean_kubernetes_options" "foobar" {}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.digitalocean_kubernetes_options.foobar", "versions.0.slug"),
+					resource.TestCheckResourceAttrSet("data.digitalocean_kubernetes_options.foobar", "versions.0.kubernetes_version"),
+					resource.TestCheckResourceAttrSet("data.digitalocean_kubernetes_options.foobar", "regions.0.slug"),
+					resource.TestCheckResourceAttrSet("data.digitalocean_kubernetes_options.foobar", "sizes.0.slug"),
+				),
+			},
+		},
+	})
+}
@@ -118,6 +118,40 @@ func nodePoolSchema(isResource bool) map[string]*schema.Schema {
 			ForceNew:     true,
 		}
 
+		// changing the trigger recycles the pool's nodes
+		s["recycle_trigger"] = &schema.Schema{
+			Type:     schema.TypeString,
+			Optional: true,
+		}
+
+		s["recycle_node_ids"] = &schema.Schema{
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		}
+
+		s["recycle_skip_drain"] = &schema.Schema{
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+		}
+
+		s["replacement_strategy"] = &schema.Schema{
+			Type:     schema.TypeString,
+			Optional: true,
+			Default:  nodePoolReplacementStrategyDestroyBeforeCreate,
+			ValidateFunc: validation.StringInSlice([]string{
+				nodePoolReplacementStrategyDestroyBeforeCreate,
+				nodePoolReplacementStrategyCreateBeforeDestroyWithDrain,
+			}, false),
+		}
+
+		// whether changing these fields replaces the resource depends on
+		// the replacement strategy, see the resource's CustomizeDiff
+		for _, field := range nodePoolReplacementFields {
+			s[field].ForceNew = false
+		}
+
 		// remove the id when this is used in a specific resource
 		// not as a child
 		delete(s, "id")
@@ -0,0 +1,122 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// validateKubernetesClusterSizeRegion rejects a cluster whose region or default
+// node pool size is not supported, rather than failing during apply.
+func validateKubernetesClusterSizeRegion(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.HasChanges("region", "node_pool.0.size") {
+		return nil
+	}
+
+	if !d.NewValueKnown("region") || !d.NewValueKnown("node_pool.0.size") {
+		return nil
+	}
+
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	return validateKubernetesSizeRegion(ctx, client, d.Get("region").(string), d.Get("node_pool.0.size").(string))
+}
+
+// validateKubernetesNodePoolSizeRegion rejects a node pool whose size is not
+// supported in the region of its cluster, rather than failing during apply.
+func validateKubernetesNodePoolSizeRegion(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.HasChange("size") || !d.NewValueKnown("size") || !d.NewValueKnown("cluster_id") {
+		return nil
+	}
+
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	clusterID := d.Get("cluster_id").(string)
+	cluster, _, err := client.Kubernetes.Get(ctx, clusterID)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Kubernetes cluster (%s): %s", clusterID, err)
+	}
+
+	return validateKubernetesSizeRegion(ctx, client, cluster.RegionSlug, d.Get("size").(string))
+}
+
+func validateKubernetesSizeRegion(ctx context.Context, client *godo.Client, region, size string) error {
+	options, _, err := client.Kubernetes.GetOptions(ctx)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Kubernetes options: %s", err)
+	}
+
+	dropletSizes, err := listDropletSizes(ctx, client)
+	if err != nil {
+		return err
+	}
+
+	return checkKubernetesSizeRegionSupported(region, size, options, dropletSizes)
+}
+
+func checkKubernetesSizeRegionSupported(region, size string, options *godo.KubernetesOptions, dropletSizes []godo.Size) error {
+	regions := make([]string, 0, len(options.Regions))
+	for _, r := range options.Regions {
+		regions = append(regions, r.Slug)
+	}
+
+	if region != "" && !slices.Contains(regions, region) {
+		return fmt.Errorf("region %s does not support Kubernetes clusters, supported regions are %s", region, strings.Join(regions, ", "))
+	}
+
+	if size == "" {
+		return nil
+	}
+
+	supported := slices.ContainsFunc(options.Sizes, func(s *godo.KubernetesNodeSize) bool {
+		return s.Slug == size
+	})
+	if !supported {
+		return fmt.Errorf("size %s is not supported for Kubernetes nodes", size)
+	}
+
+	// Sizes unknown to the sizes API are left for the API to reject.
+	for _, s := range dropletSizes {
+		if s.Slug == size && (!s.Available || !slices.Contains(s.Regions, region)) {
+			return fmt.Errorf("size %s is not available in region %s", size, region)
+		}
+	}
+
+	return nil
+}
+
+func listDropletSizes(ctx context.Context, client *godo.Client) ([]godo.Size, error) {
+	var sizes []godo.Size
+
+	opts := &godo.ListOptions{
+		Page:    1,
+		PerPage: 200,
+	}
+
+	for {
+		partialSizes, resp, err := client.Sizes.List(ctx, opts)
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving sizes: %s", err)
+		}
+
+		sizes = append(sizes, partialSizes...)
+
+		if resp.Links == nil || resp.Links.IsLastPage() {
+			break
+		}
+
+		page, err := resp.Links.CurrentPage()
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving sizes: %s", err)
+		}
+
+		opts.Page = page + 1
+	}
+
+	return sizes, nil
+}
@@ -0,0 +1,50 @@
+package kubernetes
+
+import (
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckKubernetesSizeRegionSupported(t *testing.T) {
+	options := &godo.KubernetesOptions{
+		Regions: []*godo.KubernetesRegion{
+			{Name: "New York 1", Slug: "nyc1"},
+			{Name: "Toronto 1", Slug: "tor1"},
+		},
+		Sizes: []*godo.KubernetesNodeSize{
+			{Name: "s-1vcpu-2gb", Slug: "s-1vcpu-2gb"},
+			{Name: "gpu-h100x1-80gb", Slug: "gpu-h100x1-80gb"},
+			{Name: "s-2vcpu-4gb", Slug: "s-2vcpu-4gb"},
+		},
+	}
+	dropletSizes := []godo.Size{
+		{Slug: "s-1vcpu-2gb", Available: true, Regions: []string{"nyc1", "tor1"}},
+		{Slug: "gpu-h100x1-80gb", Available: true, Regions: []string{"tor1"}},
+		{Slug: "s-1vcpu-1gb", Available: true, Regions: []string{"nyc1", "tor1"}},
+	}
+
+	cases := []struct {
+		region   string
+		size     string
+		expected string
+	}{
+		{"nyc1", "s-1vcpu-2gb", ""},
+		{"tor1", "gpu-h100x1-80gb", ""},
+		{"nyc1", "", ""},
+		// Not listed by the sizes API.
+		{"nyc1", "s-2vcpu-4gb", ""},
+		{"sfo1", "s-1vcpu-2gb", "region sfo1 does not support Kubernetes clusters, supported regions are nyc1, tor1"},
+		{"nyc1", "s-1vcpu-1gb", "size s-1vcpu-1gb is not supported for Kubernetes nodes"},
+		{"nyc1", "gpu-h100x1-80gb", "size gpu-h100x1-80gb is not available in region nyc1"},
+	}
+	for _, c := range cases {
+		err := checkKubernetesSizeRegionSupported(c.region, c.size, options, dropletSizes)
+		if c.expected == "" {
+			assert.NoError(t, err, "%s/%s", c.region, c.size)
+		} else {
+			assert.EqualError(t, err, c.expected, "%s/%s", c.region, c.size)
+		}
+	}
+}
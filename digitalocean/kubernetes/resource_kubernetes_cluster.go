@@ -6,6 +6,8 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"slices"
+	"sort"
 	"strings"
 	"time"
 
@@ -13,6 +15,7 @@ import (
 	"github.com/hashicorp/go-version"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	yaml "gopkg.in/yaml.v2"
@@ -105,6 +108,23 @@ func ResourceDigitalOceanKubernetesCluster() *schema.Resource {
 				ValidateFunc: validation.NoZeroValues,
 			},
 
+			"max_minor_version_skip": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			"allowed_upgrade_versions": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.NoZeroValues,
+				},
+			},
+
+			"preflight_clusterlint": preflightClusterlintSchema(),
+
 			"vpc_uuid": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -455,6 +475,7 @@ func ResourceDigitalOceanKubernetesCluster() *schema.Resource {
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		CustomizeDiff: customdiff.All(
@@ -476,10 +497,92 @@ func ResourceDigitalOceanKubernetesCluster() *schema.Resource {
 				}
 				return false
 			}),
+			customdiff.IfValueChange("version", func(ctx context.Context, old, new, meta interface{}) bool {
+				return old.(string) != "" && new.(string) != ""
+			}, validateKubernetesClusterUpgrade),
+			validateKubernetesClusterSizeRegion,
 		),
 	}
 }
 
+// validateKubernetesClusterUpgrade refuses a version change that is not one
+// of the cluster's available upgrades, is not in allowed_upgrade_versions
+// when set, or skips more than max_minor_version_skip minor versions when
+// set. Without either guard, upgrades are not checked.
+func validateKubernetesClusterUpgrade(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || !d.NewValueKnown("version") || !d.NewValueKnown("allowed_upgrade_versions") {
+		return nil
+	}
+
+	maxSkip := d.Get("max_minor_version_skip").(int)
+	var allowed []string
+	for _, v := range d.Get("allowed_upgrade_versions").(*schema.Set).List() {
+		allowed = append(allowed, v.(string))
+	}
+	if maxSkip == 0 && len(allowed) == 0 {
+		return nil
+	}
+
+	old, new := d.GetChange("version")
+
+	// Downgrades force a new cluster instead of an upgrade.
+	newVer, newErr := version.NewVersion(new.(string))
+	oldVer, oldErr := version.NewVersion(old.(string))
+	if newErr == nil && oldErr == nil && newVer.LessThan(oldVer) {
+		return nil
+	}
+
+	client := meta.(*config.CombinedConfig).GodoClient()
+	upgrades, _, err := client.Kubernetes.GetUpgrades(ctx, d.Id())
+	if err != nil {
+		return fmt.Errorf("Error retrieving available upgrades for Kubernetes cluster (%s): %s", d.Id(), err)
+	}
+
+	return checkKubernetesUpgradeAllowed(old.(string), new.(string), maxSkip, allowed, upgrades)
+}
+
+func checkKubernetesUpgradeAllowed(old, new string, maxSkip int, allowed []string, upgrades []*godo.KubernetesVersion) error {
+	available := make([]string, 0, len(upgrades))
+	for _, v := range upgrades {
+		available = append(available, v.Slug)
+	}
+
+	if !slices.Contains(available, new) {
+		if len(available) == 0 {
+			return fmt.Errorf("Kubernetes cluster cannot be upgraded from %s to %s: no upgrades are available", old, new)
+		}
+		return fmt.Errorf("Kubernetes cluster cannot be upgraded from %s to %s: available upgrades are %s", old, new, strings.Join(available, ", "))
+	}
+
+	if len(allowed) > 0 && !slices.Contains(allowed, new) {
+		sort.Strings(allowed)
+		return fmt.Errorf("Kubernetes cluster cannot be upgraded from %s to %s: allowed_upgrade_versions are %s", old, new, strings.Join(allowed, ", "))
+	}
+
+	if maxSkip == 0 {
+		return nil
+	}
+
+	oldVer, err := version.NewVersion(old)
+	if err != nil {
+		return nil
+	}
+	newVer, err := version.NewVersion(new)
+	if err != nil {
+		return nil
+	}
+
+	oldSegments, newSegments := oldVer.Segments(), newVer.Segments()
+	if oldSegments[0] != newSegments[0] {
+		return fmt.Errorf("Kubernetes cluster cannot be upgraded from %s to %s: upgrade changes the major version", old, new)
+	}
+	if newSegments[1]-oldSegments[1] > maxSkip {
+		return fmt.Errorf("Kubernetes cluster cannot be upgraded from %s to %s: upgrade skips more than %d minor version(s)", old, new, maxSkip)
+	}
+
+	return nil
+}
+
 func kubernetesConfigSchema() *schema.Schema {
 	return &schema.Schema{
 		Type:      schema.TypeList,
@@ -807,6 +910,22 @@ func digitaloceanKubernetesClusterRead(
 func resourceDigitalOceanKubernetesClusterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*config.CombinedConfig).GodoClient()
 
+	// Status messages are collected from slightly before the update began to
+	// allow for clock skew.
+	start := time.Now().Add(-time.Minute)
+
+	// The preflight runs before any other change is made, so a refused
+	// upgrade leaves the cluster untouched.
+	if d.HasChange("version") {
+		if v, ok := d.GetOk("preflight_clusterlint"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			if err := preflightKubernetesClusterlint(ctx, client, d.Id(), v.([]interface{})[0].(map[string]interface{}), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				// Keep the previous version in state so the upgrade is planned again.
+				d.Partial(true)
+				return diag.Errorf("Refusing to upgrade Kubernetes cluster (%s): %s", d.Id(), err)
+			}
+		}
+	}
+
 	// Figure out the changes and then call the appropriate API methods
 	if d.HasChanges("name", "tags", "auto_upgrade", "surge_upgrade", "maintenance_policy", "ha",
 		controlPlaneFirewallField, "cluster_autoscaler_configuration", routingAgentField, p2pOciRegistryPluginField, amdGpuDevicePluginField,
@@ -848,7 +967,7 @@ func resourceDigitalOceanKubernetesClusterUpdate(ctx context.Context, d *schema.
 				return nil
 			}
 
-			return diag.Errorf("Unable to update cluster: %s", err)
+			return diag.FromErr(withKubernetesClusterStatusMessages(client, d.Id(), start, fmt.Errorf("Unable to update cluster: %s", err)))
 		}
 	}
 
@@ -867,7 +986,7 @@ func resourceDigitalOceanKubernetesClusterUpdate(ctx context.Context, d *schema.
 		timeout := d.Timeout(schema.TimeoutCreate)
 		_, err := digitaloceanKubernetesNodePoolUpdate(client, timeout, newPool, d.Id(), oldPool["id"].(string), DigitaloceanKubernetesDefaultNodePoolTag)
 		if err != nil {
-			return diag.FromErr(err)
+			return diag.FromErr(withKubernetesClusterStatusMessages(client, d.Id(), start, err))
 		}
 	}
 
@@ -878,7 +997,11 @@ func resourceDigitalOceanKubernetesClusterUpdate(ctx context.Context, d *schema.
 
 		_, err := client.Kubernetes.Upgrade(context.Background(), d.Id(), opts)
 		if err != nil {
-			return diag.Errorf("Unable to upgrade cluster version: %s", err)
+			return diag.FromErr(withKubernetesClusterStatusMessages(client, d.Id(), start, fmt.Errorf("Unable to upgrade cluster version: %s", err)))
+		}
+
+		if err := waitForKubernetesClusterUpgrade(ctx, client, d.Id(), opts.VersionSlug, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.FromErr(withKubernetesClusterStatusMessages(client, d.Id(), start, err))
 		}
 	}
 
@@ -1008,6 +1131,50 @@ func disableRegistryIntegration(client *godo.Client, clusterUUID string) error {
 	return err
 }
 
+// waitForKubernetesClusterUpgrade waits for the cluster to be running the
+// given version once an upgrade has been accepted.
+func waitForKubernetesClusterUpgrade(ctx context.Context, client *godo.Client, id, versionSlug string, timeout time.Duration) error {
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{"upgrading"},
+		Target:     []string{"running"},
+		Refresh:    kubernetesClusterUpgradeRefreshFunc(ctx, client, id, versionSlug),
+		MinTimeout: 10 * time.Second,
+		Timeout:    timeout,
+	}
+
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("Error waiting for cluster to be upgraded to %s: %s", versionSlug, err)
+	}
+
+	return nil
+}
+
+// kubernetesClusterUpgradeRefreshFunc reports the cluster as running once it
+// is running the given version, and fails if the cluster errors or degrades.
+func kubernetesClusterUpgradeRefreshFunc(ctx context.Context, client *godo.Client, id, versionSlug string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		cluster, _, err := client.Kubernetes.Get(ctx, id)
+		if err != nil {
+			return nil, "", fmt.Errorf("Error trying to read cluster state: %s", err)
+		}
+
+		if cluster.Status == nil {
+			return cluster, "upgrading", nil
+		}
+
+		switch cluster.Status.State {
+		case godo.KubernetesClusterStatusError, godo.KubernetesClusterStatusDegraded:
+			return nil, "", fmt.Errorf("cluster is %s: %s", cluster.Status.State, cluster.Status.Message)
+		case godo.KubernetesClusterStatusRunning:
+			if cluster.VersionSlug == versionSlug {
+				return cluster, "running", nil
+			}
+		}
+
+		return cluster, "upgrading", nil
+	}
+}
+
 func waitForKubernetesClusterCreate(client *godo.Client, d *schema.ResourceData) (*godo.KubernetesCluster, error) {
 	var (
 		tickerInterval = 10 * time.Second
@@ -1017,6 +1184,10 @@ func waitForKubernetesClusterCreate(client *godo.Client, d *schema.ResourceData)
 		ticker         = time.NewTicker(tickerInterval)
 	)
 
+	// Status messages are collected from slightly before the wait began to
+	// allow for clock skew.
+	start := time.Now().Add(-time.Minute)
+
 	for range ticker.C {
 		cluster, _, err := client.Kubernetes.Get(context.Background(), d.Id())
 		if err != nil {
@@ -1031,7 +1202,7 @@ func waitForKubernetesClusterCreate(client *godo.Client, d *schema.ResourceData)
 
 		if cluster.Status.State == "error" {
 			ticker.Stop()
-			return nil, errors.New(cluster.Status.Message)
+			return nil, withKubernetesClusterStatusMessages(client, d.Id(), start, errors.New(cluster.Status.Message))
 		}
 
 		if n > timeout {
@@ -1042,7 +1213,7 @@ func waitForKubernetesClusterCreate(client *godo.Client, d *schema.ResourceData)
 		n++
 	}
 
-	return nil, fmt.Errorf("Timeout waiting to create cluster")
+	return nil, withKubernetesClusterStatusMessages(client, d.Id(), start, fmt.Errorf("Timeout waiting to create cluster"))
 }
 
 type kubernetesConfig struct {
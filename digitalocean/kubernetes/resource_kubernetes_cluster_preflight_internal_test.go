@@ -0,0 +1,90 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+// A refused upgrade must not change the cluster, and must keep the previous
+// version in state so the upgrade is planned again.
+func TestKubernetesClusterUpdatePreflightRefusal(t *testing.T) {
+	clusterID := "cluster-1"
+
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	mux.HandleFunc("/v2/kubernetes/clusters/cluster-1/clusterlint", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			fmt.Fprint(w, `{"run_id": "run-1"}`)
+			return
+		}
+		fmt.Fprint(w, `{"diagnostics": [{"check_name": "deprecated-apis", "severity": "error", "message": "uses a removed API"}]}`)
+	})
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	cfg := &config.Config{
+		Token:       "test-token",
+		APIEndpoint: server.URL,
+	}
+	client, err := cfg.Client()
+	if err != nil {
+		t.Fatalf("error building client: %s", err)
+	}
+
+	r := ResourceDigitalOceanKubernetesCluster()
+	state := &terraform.InstanceState{
+		ID: clusterID,
+		Attributes: map[string]string{
+			"id":                      clusterID,
+			"name":                    "foobar",
+			"region":                  "nyc1",
+			"version":                 "1.32.2-do.1",
+			"isolated_workers":        "false",
+			"preflight_clusterlint.#": "1",
+			"preflight_clusterlint.0.fail_on_severity": "error",
+			"node_pool.#":            "1",
+			"node_pool.0.name":       "default",
+			"node_pool.0.size":       "s-1vcpu-2gb",
+			"node_pool.0.node_count": "1",
+		},
+	}
+	raw := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":    "foobar",
+		"region":  "nyc1",
+		"version": "1.33.0-do.0",
+		"preflight_clusterlint": []interface{}{
+			map[string]interface{}{"fail_on_severity": "error"},
+		},
+		"node_pool": []interface{}{
+			map[string]interface{}{
+				"name":       "default",
+				"size":       "s-1vcpu-2gb",
+				"node_count": 1,
+			},
+		},
+	})
+
+	diff, err := r.Diff(context.Background(), state, raw, client)
+	if err != nil {
+		t.Fatalf("diff returned error: %s", err)
+	}
+
+	newState, diags := r.Apply(context.Background(), state, diff, client)
+	if !diags.HasError() {
+		t.Fatal("expected the upgrade to be refused")
+	}
+	assert.Contains(t, diags[0].Summary, "Refusing to upgrade Kubernetes cluster (cluster-1)")
+	assert.Contains(t, diags[0].Summary, "deprecated-apis")
+	assert.Equal(t, "1.32.2-do.1", newState.Attributes["version"])
+}
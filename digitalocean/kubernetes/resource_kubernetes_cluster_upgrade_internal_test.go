@@ -0,0 +1,311 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckKubernetesUpgradeAllowed(t *testing.T) {
+	upgrades := []*godo.KubernetesVersion{
+		{Slug: "1.31.1-do.0"},
+		{Slug: "1.32.2-do.1"},
+		{Slug: "1.33.0-do.0"},
+	}
+
+	assert.NoError(t, checkKubernetesUpgradeAllowed("1.31.0-do.0", "1.31.1-do.0", 1, nil, upgrades))
+	assert.NoError(t, checkKubernetesUpgradeAllowed("1.31.0-do.0", "1.32.2-do.1", 1, nil, upgrades))
+	assert.NoError(t, checkKubernetesUpgradeAllowed("1.31.0-do.0", "1.33.0-do.0", 2, nil, upgrades))
+
+	err := checkKubernetesUpgradeAllowed("1.31.0-do.0", "1.33.0-do.0", 1, nil, upgrades)
+	assert.ErrorContains(t, err, "skips more than 1 minor version(s)")
+
+	err = checkKubernetesUpgradeAllowed("1.31.0-do.0", "1.34.0-do.0", 5, nil, upgrades)
+	assert.ErrorContains(t, err, "available upgrades are 1.31.1-do.0, 1.32.2-do.1, 1.33.0-do.0")
+
+	err = checkKubernetesUpgradeAllowed("1.31.0-do.0", "1.32.2-do.1", 1, nil, nil)
+	assert.ErrorContains(t, err, "no upgrades are available")
+
+	// 0 leaves the number of minor versions skipped unchecked.
+	assert.NoError(t, checkKubernetesUpgradeAllowed("1.31.0-do.0", "1.33.0-do.0", 0, nil, upgrades))
+
+	allowed := []string{"1.32.2-do.1", "1.31.1-do.0"}
+	assert.NoError(t, checkKubernetesUpgradeAllowed("1.31.0-do.0", "1.32.2-do.1", 0, allowed, upgrades))
+
+	err = checkKubernetesUpgradeAllowed("1.31.0-do.0", "1.33.0-do.0", 0, allowed, upgrades)
+	assert.ErrorContains(t, err, "allowed_upgrade_versions are 1.31.1-do.0, 1.32.2-do.1")
+
+	err = checkKubernetesUpgradeAllowed("1.31.0-do.0", "1.34.0-do.0", 0, []string{"1.34.0-do.0"}, upgrades)
+	assert.ErrorContains(t, err, "available upgrades are")
+}
+
+func TestLatestKubernetesVersion(t *testing.T) {
+	assert.Equal(t, "", latestKubernetesVersion(nil))
+	assert.Equal(t, "1.33.0-do.0", latestKubernetesVersion([]*godo.KubernetesVersion{
+		{Slug: "1.32.2-do.1"},
+		{Slug: "1.33.0-do.0"},
+		{Slug: "1.31.1-do.0"},
+	}))
+	assert.Equal(t, "latest", latestKubernetesVersion([]*godo.KubernetesVersion{
+		{Slug: "latest"},
+	}))
+}
+
+// A failed upgrade includes the control plane status messages in the error.
+func TestKubernetesClusterUpgradeStatusMessages(t *testing.T) {
+	clusterID := "cluster-1"
+
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	mux.HandleFunc("/v2/kubernetes/clusters/cluster-1", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method)
+		fmt.Fprint(w, `{"kubernetes_cluster": {"id": "cluster-1"}}`)
+	})
+	mux.HandleFunc("/v2/kubernetes/clusters/cluster-1/upgrade", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		fmt.Fprint(w, `{"id": "unprocessable_entity", "message": "upgrade failed"}`)
+	})
+	mux.HandleFunc("/v2/kubernetes/clusters/cluster-1/status_messages", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"messages": [{"message": "control plane unhealthy", "timestamp": "2024-06-01T12:05:00Z"}]}`)
+	})
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	cfg := &config.Config{
+		Token:       "test-token",
+		APIEndpoint: server.URL,
+	}
+	client, err := cfg.Client()
+	if err != nil {
+		t.Fatalf("error building client: %s", err)
+	}
+
+	r := ResourceDigitalOceanKubernetesCluster()
+	state := &terraform.InstanceState{
+		ID: clusterID,
+		Attributes: map[string]string{
+			"id":                     clusterID,
+			"name":                   "foobar",
+			"region":                 "nyc1",
+			"version":                "1.32.2-do.1",
+			"isolated_workers":       "false",
+			"node_pool.#":            "1",
+			"node_pool.0.name":       "default",
+			"node_pool.0.size":       "s-1vcpu-2gb",
+			"node_pool.0.node_count": "1",
+		},
+	}
+	raw := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":    "foobar",
+		"region":  "nyc1",
+		"version": "1.33.0-do.0",
+		"node_pool": []interface{}{
+			map[string]interface{}{
+				"name":       "default",
+				"size":       "s-1vcpu-2gb",
+				"node_count": 1,
+			},
+		},
+	})
+
+	diff, err := r.Diff(context.Background(), state, raw, client)
+	if err != nil {
+		t.Fatalf("diff returned error: %s", err)
+	}
+
+	_, diags := r.Apply(context.Background(), state, diff, client)
+	if !diags.HasError() {
+		t.Fatal("expected the upgrade to fail")
+	}
+	assert.Contains(t, diags[0].Summary, "Unable to upgrade cluster version")
+	assert.Contains(t, diags[0].Summary, "2024-06-01T12:05:00Z: control plane unhealthy")
+}
+
+// Without max_minor_version_skip or allowed_upgrade_versions, a version change
+// is planned without retrieving the available upgrades.
+func TestValidateKubernetesClusterUpgradeWithoutGuards(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	cfg := &config.Config{
+		Token:       "test-token",
+		APIEndpoint: server.URL,
+	}
+	client, err := cfg.Client()
+	if err != nil {
+		t.Fatalf("error building client: %s", err)
+	}
+
+	r := ResourceDigitalOceanKubernetesCluster()
+	state := &terraform.InstanceState{
+		ID: "cluster-1",
+		Attributes: map[string]string{
+			"id":                     "cluster-1",
+			"name":                   "foobar",
+			"region":                 "nyc1",
+			"version":                "1.31.0-do.0",
+			"isolated_workers":       "false",
+			"node_pool.#":            "1",
+			"node_pool.0.name":       "default",
+			"node_pool.0.size":       "s-1vcpu-2gb",
+			"node_pool.0.node_count": "1",
+		},
+	}
+	raw := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":    "foobar",
+		"region":  "nyc1",
+		"version": "1.34.0-do.0",
+		"node_pool": []interface{}{
+			map[string]interface{}{
+				"name":       "default",
+				"size":       "s-1vcpu-2gb",
+				"node_count": 1,
+			},
+		},
+	})
+
+	diff, err := r.Diff(context.Background(), state, raw, client)
+	if err != nil {
+		t.Fatalf("diff returned error: %s", err)
+	}
+	assert.Equal(t, "1.34.0-do.0", diff.Attributes["version"].New)
+}
+
+// An upgrade which fails after being accepted includes the control plane status
+// messages in the error.
+func TestKubernetesClusterUpgradeFailedStatusMessages(t *testing.T) {
+	clusterID := "cluster-1"
+
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	mux.HandleFunc("/v2/kubernetes/clusters/cluster-1", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPut {
+			fmt.Fprint(w, `{"kubernetes_cluster": {"id": "cluster-1"}}`)
+			return
+		}
+		fmt.Fprint(w, `{"kubernetes_cluster": {"id": "cluster-1", "version": "1.32.2-do.1", "status": {"state": "error", "message": "upgrade failed"}}}`)
+	})
+	mux.HandleFunc("/v2/kubernetes/clusters/cluster-1/upgrade", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusAccepted)
+	})
+	mux.HandleFunc("/v2/kubernetes/clusters/cluster-1/status_messages", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"messages": [{"message": "control plane unhealthy", "timestamp": "2024-06-01T12:05:00Z"}]}`)
+	})
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	cfg := &config.Config{
+		Token:       "test-token",
+		APIEndpoint: server.URL,
+	}
+	client, err := cfg.Client()
+	if err != nil {
+		t.Fatalf("error building client: %s", err)
+	}
+
+	r := ResourceDigitalOceanKubernetesCluster()
+	state := &terraform.InstanceState{
+		ID: clusterID,
+		Attributes: map[string]string{
+			"id":                     clusterID,
+			"name":                   "foobar",
+			"region":                 "nyc1",
+			"version":                "1.32.2-do.1",
+			"isolated_workers":       "false",
+			"node_pool.#":            "1",
+			"node_pool.0.name":       "default",
+			"node_pool.0.size":       "s-1vcpu-2gb",
+			"node_pool.0.node_count": "1",
+		},
+	}
+	raw := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":    "foobar",
+		"region":  "nyc1",
+		"version": "1.33.0-do.0",
+		"node_pool": []interface{}{
+			map[string]interface{}{
+				"name":       "default",
+				"size":       "s-1vcpu-2gb",
+				"node_count": 1,
+			},
+		},
+	})
+
+	diff, err := r.Diff(context.Background(), state, raw, client)
+	if err != nil {
+		t.Fatalf("diff returned error: %s", err)
+	}
+
+	_, diags := r.Apply(context.Background(), state, diff, client)
+	if !diags.HasError() {
+		t.Fatal("expected the upgrade to fail")
+	}
+	assert.Contains(t, diags[0].Summary, "Error waiting for cluster to be upgraded to 1.33.0-do.0: cluster is error: upgrade failed")
+	assert.Contains(t, diags[0].Summary, "2024-06-01T12:05:00Z: control plane unhealthy")
+}
+
+func TestKubernetesClusterUpgradeRefreshFunc(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	cluster := ""
+	mux.HandleFunc("/v2/kubernetes/clusters/cluster-1", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"kubernetes_cluster": %s}`, cluster)
+	})
+
+	cfg := &config.Config{
+		Token:       "test-token",
+		APIEndpoint: server.URL,
+	}
+	combined, err := cfg.Client()
+	if err != nil {
+		t.Fatalf("error building client: %s", err)
+	}
+	refresh := kubernetesClusterUpgradeRefreshFunc(context.Background(), combined.GodoClient(), "cluster-1", "1.33.0-do.0")
+
+	states := []struct {
+		cluster  string
+		expected string
+	}{
+		// The upgrade has not started yet.
+		{`{"version": "1.32.2-do.1", "status": {"state": "running"}}`, "upgrading"},
+		{`{"version": "1.32.2-do.1", "status": {"state": "upgrading"}}`, "upgrading"},
+		{`{"version": "1.33.0-do.0", "status": {"state": "upgrading"}}`, "upgrading"},
+		{`{"version": "1.33.0-do.0", "status": {"state": "running"}}`, "running"},
+	}
+	for _, s := range states {
+		cluster = s.cluster
+
+		_, state, err := refresh()
+		if err != nil {
+			t.Fatalf("refresh returned error: %s", err)
+		}
+		assert.Equal(t, s.expected, state, "cluster: %s", s.cluster)
+	}
+
+	cluster = `{"version": "1.32.2-do.1", "status": {"state": "degraded", "message": "nodes not ready"}}`
+	_, _, err = refresh()
+	assert.EqualError(t, err, "cluster is degraded: nodes not ready")
+}
@@ -3,14 +3,18 @@ package kubernetes
 import (
 	"context"
 	"fmt"
+	"log"
 	"net/http"
 	"slices"
+	"strconv"
 	"time"
 
 	"github.com/digitalocean/godo"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/tag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -18,6 +22,15 @@ import (
 // we automatically add this tag to the default pool
 const DigitaloceanKubernetesDefaultNodePoolTag = "terraform:default-node-pool"
 
+const (
+	nodePoolReplacementStrategyDestroyBeforeCreate          = "destroy_before_create"
+	nodePoolReplacementStrategyCreateBeforeDestroyWithDrain = "create_before_destroy_with_drain"
+)
+
+// nodePoolReplacementFields cannot be changed on an existing node pool, so
+// changing them replaces the pool according to its replacement_strategy.
+var nodePoolReplacementFields = []string{"size", "gpu_partition_mode"}
+
 func ResourceDigitalOceanKubernetesNodePool() *schema.Resource {
 
 	return &schema.Resource{
@@ -32,8 +45,14 @@ func ResourceDigitalOceanKubernetesNodePool() *schema.Resource {
 
 		Schema: nodePoolSchema(true),
 
+		CustomizeDiff: customdiff.All(
+			customizeDiffKubernetesNodePoolReplacement,
+			validateKubernetesNodePoolSizeRegion,
+		),
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
 			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 	}
@@ -104,6 +123,40 @@ func resourceDigitalOceanKubernetesNodePoolRead(ctx context.Context, d *schema.R
 func resourceDigitalOceanKubernetesNodePoolUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*config.CombinedConfig).GodoClient()
 
+	// Only reachable with the create_before_destroy_with_drain strategy,
+	// otherwise these changes force a new resource.
+	if d.HasChanges(nodePoolReplacementFields...) {
+		if err := replaceKubernetesNodePool(ctx, client, d); err != nil {
+			return diag.FromErr(err)
+		}
+
+		return resourceDigitalOceanKubernetesNodePoolRead(ctx, d, meta)
+	}
+
+	if d.HasChangesExcept("recycle_trigger", "recycle_node_ids", "recycle_skip_drain", "replacement_strategy") {
+		if diags := updateDigitalOceanKubernetesNodePool(d, client); diags.HasError() {
+			return diags
+		}
+	}
+
+	if d.HasChange("recycle_trigger") && d.Get("recycle_trigger").(string) != "" {
+		var nodeIDs []string
+		for _, id := range d.Get("recycle_node_ids").(*schema.Set).List() {
+			nodeIDs = append(nodeIDs, id.(string))
+		}
+
+		err := recycleKubernetesNodePoolNodes(ctx, client, time.Now().Add(d.Timeout(schema.TimeoutUpdate)), d.Get("cluster_id").(string), d.Id(), nodeIDs, d.Get("recycle_skip_drain").(bool))
+		if err != nil {
+			// Keep the previous trigger in state so the recycle is retried.
+			d.Partial(true)
+			return diag.Errorf("Error recycling node pool (%s) nodes: %s", d.Id(), err)
+		}
+	}
+
+	return resourceDigitalOceanKubernetesNodePoolRead(ctx, d, meta)
+}
+
+func updateDigitalOceanKubernetesNodePool(d *schema.ResourceData, client *godo.Client) diag.Diagnostics {
 	rawPool := map[string]interface{}{
 		"name": d.Get("name"),
 		"tags": d.Get("tags"),
@@ -127,7 +180,7 @@ func resourceDigitalOceanKubernetesNodePoolUpdate(ctx context.Context, d *schema
 		return diag.Errorf("Error updating node pool: %s", err)
 	}
 
-	return resourceDigitalOceanKubernetesNodePoolRead(ctx, d, meta)
+	return nil
 }
 
 func resourceDigitalOceanKubernetesNodePoolDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -146,6 +199,10 @@ func resourceDigitalOceanKubernetesNodePoolDelete(ctx context.Context, d *schema
 }
 
 func resourceDigitalOceanKubernetesNodePoolImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	// Arguments which are not returned by the API are set to their defaults.
+	d.Set("recycle_skip_drain", false)
+	d.Set("replacement_strategy", nodePoolReplacementStrategyDestroyBeforeCreate)
+
 	if _, ok := d.GetOk("cluster_id"); ok {
 		// Short-circuit: The resource already has a cluster ID, no need to search for it.
 		return []*schema.ResourceData{d}, nil
@@ -362,3 +419,244 @@ func waitForKubernetesNodePoolDelete(client *godo.Client, d *schema.ResourceData
 
 	return fmt.Errorf("Timeout waiting to delete nodepool")
 }
+
+// recycleKubernetesNodePoolNodes replaces the given nodes, or all nodes in the
+// pool if none are given, one at a time. Each node is drained unless skipDrain
+// is set, and its replacement must be running before the next node is
+// recycled. All nodes must be recycled by the deadline.
+func recycleKubernetesNodePoolNodes(ctx context.Context, client *godo.Client, deadline time.Time, clusterID, poolID string, nodeIDs []string, skipDrain bool) error {
+	pool, _, err := client.Kubernetes.GetNodePool(ctx, clusterID, poolID)
+	if err != nil {
+		return fmt.Errorf("Error trying to read nodepool state: %s", err)
+	}
+
+	poolNodeIDs := make([]string, 0, len(pool.Nodes))
+	for _, node := range pool.Nodes {
+		poolNodeIDs = append(poolNodeIDs, node.ID)
+	}
+
+	if len(nodeIDs) == 0 {
+		nodeIDs = poolNodeIDs
+	}
+
+	for _, nodeID := range nodeIDs {
+		if !slices.Contains(poolNodeIDs, nodeID) {
+			return fmt.Errorf("node %s is not a member of node pool %s", nodeID, poolID)
+		}
+	}
+
+	for _, nodeID := range nodeIDs {
+		log.Printf("[INFO] Recycling node %s in node pool %s", nodeID, poolID)
+
+		_, err := client.Kubernetes.DeleteNode(ctx, clusterID, poolID, nodeID, &godo.KubernetesNodeDeleteRequest{
+			Replace:   true,
+			SkipDrain: skipDrain,
+		})
+		if err != nil {
+			return fmt.Errorf("Error recycling node %s: %s", nodeID, err)
+		}
+
+		stateConf := &retry.StateChangeConf{
+			Pending:    []string{"recycling"},
+			Target:     []string{"running"},
+			Refresh:    kubernetesNodeRecycleRefreshFunc(ctx, client, clusterID, poolID, nodeID),
+			Delay:      10 * time.Second,
+			MinTimeout: 10 * time.Second,
+			Timeout:    time.Until(deadline),
+		}
+
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+			return fmt.Errorf("Error waiting for node %s to be replaced: %s", nodeID, err)
+		}
+	}
+
+	return nil
+}
+
+// kubernetesNodeRecycleRefreshFunc reports the pool as running once the
+// recycled node has been removed and the pool has its full count of running
+// nodes.
+func kubernetesNodeRecycleRefreshFunc(ctx context.Context, client *godo.Client, clusterID, poolID, nodeID string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		pool, _, err := client.Kubernetes.GetNodePool(ctx, clusterID, poolID)
+		if err != nil {
+			return nil, "", fmt.Errorf("Error trying to read nodepool state: %s", err)
+		}
+
+		if len(pool.Nodes) < pool.Count {
+			return pool, "recycling", nil
+		}
+
+		for _, node := range pool.Nodes {
+			if node.ID == nodeID || node.Status == nil || node.Status.State != "running" {
+				return pool, "recycling", nil
+			}
+		}
+
+		return pool, "running", nil
+	}
+}
+
+func customizeDiffKubernetesNodePoolReplacement(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || d.Get("replacement_strategy").(string) == nodePoolReplacementStrategyCreateBeforeDestroyWithDrain {
+		return nil
+	}
+
+	for _, field := range nodePoolReplacementFields {
+		if d.HasChange(field) {
+			if err := d.ForceNew(field); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// replaceKubernetesNodePool replaces the node pool with a new one in a blue/green
+// fashion. The new pool is created under a suffixed name and must be running
+// before the nodes of the old pool are drained and deleted. The old pool is then
+// removed and the new pool takes over its name.
+func replaceKubernetesNodePool(ctx context.Context, client *godo.Client, d *schema.ResourceData) error {
+	clusterID := d.Get("cluster_id").(string)
+	oldPoolID := d.Id()
+	name := d.Get("name").(string)
+
+	// All waits share the update timeout rather than each being given the
+	// full duration.
+	deadline := time.Now().Add(d.Timeout(schema.TimeoutUpdate))
+
+	rawPool := map[string]interface{}{
+		"name":               nodePoolReplacementName(name),
+		"size":               d.Get("size"),
+		"tags":               d.Get("tags"),
+		"labels":             d.Get("labels"),
+		"node_count":         d.Get("node_count"),
+		"auto_scale":         d.Get("auto_scale"),
+		"min_nodes":          d.Get("min_nodes"),
+		"max_nodes":          d.Get("max_nodes"),
+		"taint":              d.Get("taint"),
+		"gpu_partition_mode": d.Get("gpu_partition_mode"),
+	}
+
+	log.Printf("[INFO] Creating replacement for node pool %s", oldPoolID)
+	pool, err := digitaloceanKubernetesNodePoolCreate(client, time.Until(deadline), rawPool, clusterID)
+	if err != nil {
+		return fmt.Errorf("Error creating replacement for node pool (%s): %s", oldPoolID, err)
+	}
+
+	// The new pool is running, so track it from here on. Should removing the
+	// old pool fail, it is left behind rather than the new one.
+	d.SetId(pool.ID)
+
+	if err := drainKubernetesNodePool(ctx, client, deadline, clusterID, oldPoolID); err != nil {
+		return fmt.Errorf("Error draining replaced node pool (%s), it must be removed manually: %s", oldPoolID, err)
+	}
+
+	_, err = client.Kubernetes.DeleteNodePool(ctx, clusterID, oldPoolID)
+	if err != nil {
+		return fmt.Errorf("Error deleting replaced node pool (%s), it must be removed manually: %s", oldPoolID, err)
+	}
+
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{"deleting"},
+		Target:     []string{"deleted"},
+		Refresh:    kubernetesNodePoolDeleteRefreshFunc(ctx, client, clusterID, oldPoolID),
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+		Timeout:    time.Until(deadline),
+	}
+
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("Error waiting for replaced node pool (%s) to be deleted: %s", oldPoolID, err)
+	}
+
+	// Node pool names must be unique within a cluster, so the new pool can only
+	// take over the original name once the old pool is gone.
+	if diags := updateDigitalOceanKubernetesNodePool(d, client); diags.HasError() {
+		return fmt.Errorf("Error renaming replacement node pool (%s) to %s: %s", pool.ID, name, diags[0].Summary)
+	}
+
+	return nil
+}
+
+func nodePoolReplacementName(name string) string {
+	return fmt.Sprintf("%s-%s", name, strconv.FormatInt(time.Now().Unix(), 36))
+}
+
+// drainKubernetesNodePool cordons, drains and deletes the nodes of the pool one
+// at a time, waiting for each node to be removed before moving on. All nodes
+// must be removed by the deadline.
+func drainKubernetesNodePool(ctx context.Context, client *godo.Client, deadline time.Time, clusterID, poolID string) error {
+	pool, _, err := client.Kubernetes.GetNodePool(ctx, clusterID, poolID)
+	if err != nil {
+		return fmt.Errorf("Error trying to read nodepool state: %s", err)
+	}
+
+	// Keep the autoscaler from replacing the nodes as they are removed.
+	if pool.AutoScale {
+		_, _, err := client.Kubernetes.UpdateNodePool(ctx, clusterID, poolID, &godo.KubernetesNodePoolUpdateRequest{
+			Name:      pool.Name,
+			Count:     godo.PtrTo(pool.Count),
+			AutoScale: godo.PtrTo(false),
+		})
+		if err != nil {
+			return fmt.Errorf("Error disabling auto-scaling: %s", err)
+		}
+	}
+
+	for _, node := range pool.Nodes {
+		log.Printf("[INFO] Draining node %s in node pool %s", node.ID, poolID)
+
+		_, err := client.Kubernetes.DeleteNode(ctx, clusterID, poolID, node.ID, &godo.KubernetesNodeDeleteRequest{})
+		if err != nil {
+			return fmt.Errorf("Error draining node %s: %s", node.ID, err)
+		}
+
+		stateConf := &retry.StateChangeConf{
+			Pending:    []string{"draining"},
+			Target:     []string{"deleted"},
+			Refresh:    kubernetesNodeDeleteRefreshFunc(ctx, client, clusterID, poolID, node.ID),
+			Delay:      10 * time.Second,
+			MinTimeout: 10 * time.Second,
+			Timeout:    time.Until(deadline),
+		}
+
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+			return fmt.Errorf("Error waiting for node %s to be drained: %s", node.ID, err)
+		}
+	}
+
+	return nil
+}
+
+func kubernetesNodeDeleteRefreshFunc(ctx context.Context, client *godo.Client, clusterID, poolID, nodeID string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		pool, _, err := client.Kubernetes.GetNodePool(ctx, clusterID, poolID)
+		if err != nil {
+			return nil, "", fmt.Errorf("Error trying to read nodepool state: %s", err)
+		}
+
+		for _, node := range pool.Nodes {
+			if node.ID == nodeID {
+				return pool, "draining", nil
+			}
+		}
+
+		return pool, "deleted", nil
+	}
+}
+
+func kubernetesNodePoolDeleteRefreshFunc(ctx context.Context, client *godo.Client, clusterID, poolID string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		pool, resp, err := client.Kubernetes.GetNodePool(ctx, clusterID, poolID)
+		if err != nil {
+			if resp != nil && resp.StatusCode == http.StatusNotFound {
+				return poolID, "deleted", nil
+			}
+			return nil, "", fmt.Errorf("Error trying to read nodepool state: %s", err)
+		}
+
+		return pool, "deleting", nil
+	}
+}
@@ -0,0 +1,52 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKubernetesNodeRecycleRefreshFunc(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	nodes := `[{"id": "old", "status": {"state": "draining"}}]`
+	mux.HandleFunc("/v2/kubernetes/clusters/cluster-1/node_pools/pool-1", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"node_pool": {"id": "pool-1", "count": 1, "nodes": %s}}`, nodes)
+	})
+
+	cfg := &config.Config{
+		Token:       "test-token",
+		APIEndpoint: server.URL,
+	}
+	combined, err := cfg.Client()
+	if err != nil {
+		t.Fatalf("error building client: %s", err)
+	}
+	refresh := kubernetesNodeRecycleRefreshFunc(context.Background(), combined.GodoClient(), "cluster-1", "pool-1", "old")
+
+	states := []struct {
+		nodes    string
+		expected string
+	}{
+		{`[{"id": "old", "status": {"state": "draining"}}]`, "recycling"},
+		{`[]`, "recycling"},
+		{`[{"id": "new", "status": {"state": "provisioning"}}]`, "recycling"},
+		{`[{"id": "new", "status": {"state": "running"}}]`, "running"},
+	}
+	for _, s := range states {
+		nodes = s.nodes
+
+		_, state, err := refresh()
+		if err != nil {
+			t.Fatalf("refresh returned error: %s", err)
+		}
+		assert.Equal(t, s.expected, state, "nodes: %s", s.nodes)
+	}
+}
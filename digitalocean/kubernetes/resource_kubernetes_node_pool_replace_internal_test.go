@@ -0,0 +1,94 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKubernetesNodeDeleteRefreshFunc(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	nodes := `[]`
+	mux.HandleFunc("/v2/kubernetes/clusters/cluster-1/node_pools/pool-1", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"node_pool": {"id": "pool-1", "count": 2, "nodes": %s}}`, nodes)
+	})
+
+	cfg := &config.Config{
+		Token:       "test-token",
+		APIEndpoint: server.URL,
+	}
+	combined, err := cfg.Client()
+	if err != nil {
+		t.Fatalf("error building client: %s", err)
+	}
+	refresh := kubernetesNodeDeleteRefreshFunc(context.Background(), combined.GodoClient(), "cluster-1", "pool-1", "old")
+
+	states := []struct {
+		nodes    string
+		expected string
+	}{
+		{`[{"id": "old", "status": {"state": "running"}}, {"id": "other", "status": {"state": "running"}}]`, "draining"},
+		{`[{"id": "old", "status": {"state": "draining"}}, {"id": "other", "status": {"state": "running"}}]`, "draining"},
+		{`[{"id": "other", "status": {"state": "running"}}]`, "deleted"},
+	}
+	for _, s := range states {
+		nodes = s.nodes
+
+		_, state, err := refresh()
+		if err != nil {
+			t.Fatalf("refresh returned error: %s", err)
+		}
+		assert.Equal(t, s.expected, state, "nodes: %s", s.nodes)
+	}
+}
+
+func TestKubernetesNodePoolDeleteRefreshFunc(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	deleted := false
+	mux.HandleFunc("/v2/kubernetes/clusters/cluster-1/node_pools/pool-1", func(w http.ResponseWriter, r *http.Request) {
+		if deleted {
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"id": "not_found", "message": "node pool not found"}`)
+			return
+		}
+		fmt.Fprint(w, `{"node_pool": {"id": "pool-1", "count": 0, "nodes": []}}`)
+	})
+
+	cfg := &config.Config{
+		Token:       "test-token",
+		APIEndpoint: server.URL,
+	}
+	combined, err := cfg.Client()
+	if err != nil {
+		t.Fatalf("error building client: %s", err)
+	}
+	refresh := kubernetesNodePoolDeleteRefreshFunc(context.Background(), combined.GodoClient(), "cluster-1", "pool-1")
+
+	_, state, err := refresh()
+	assert.NoError(t, err)
+	assert.Equal(t, "deleting", state)
+
+	deleted = true
+	result, state, err := refresh()
+	assert.NoError(t, err)
+	assert.Equal(t, "deleted", state)
+	assert.NotNil(t, result)
+}
+
+func TestNodePoolReplacementName(t *testing.T) {
+	name := nodePoolReplacementName("workers")
+	assert.True(t, strings.HasPrefix(name, "workers-"), name)
+	assert.Greater(t, len(name), len("workers-"))
+}
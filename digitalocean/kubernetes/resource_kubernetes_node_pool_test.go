@@ -3,6 +3,7 @@ package kubernetes_test
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"testing"
 
 	"github.com/digitalocean/godo"
@@ -93,6 +94,149 @@ resource "digitalocean_kubernetes_cluster" "foobar" {
 	})
 }
 
+func TestAccDigitalOceanKubernetesNodePool_CreateBeforeDestroyWithDrain(t *testing.T) {
+	rName := acceptance.RandomTestName()
+	var k8s godo.KubernetesCluster
+	var k8sPool godo.KubernetesNodePool
+	var originalPoolID string
+
+	clusterConfig := fmt.Sprintf(`%s
+resource "digitalocean_kubernetes_cluster" "foobar" {
+  name    = "%s"
+  region  = "lon1"
+  version = data.digitalocean_kubernetes_versions.test.latest_version
+  ha      = false
+
+  node_pool {
+    name       = "default"
+    size       = "s-1vcpu-2gb"
+    node_count = 1
+  }
+}
+`, testClusterVersionLatest, rName)
+
+	nodePoolConfig := func(size string) string {
+		return fmt.Sprintf(`resource "digitalocean_kubernetes_node_pool" "barfoo" {
+  cluster_id = digitalocean_kubernetes_cluster.foobar.id
+
+  name                 = "%s"
+  size                 = "%s"
+  node_count           = 1
+  replacement_strategy = "create_before_destroy_with_drain"
+}
+`, rName, size)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanKubernetesClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: clusterConfig + nodePoolConfig("s-1vcpu-2gb"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDigitalOceanKubernetesClusterExists("digitalocean_kubernetes_cluster.foobar", &k8s),
+					testAccCheckDigitalOceanKubernetesNodePoolExists("digitalocean_kubernetes_node_pool.barfoo", &k8s, &k8sPool),
+					func(s *terraform.State) error {
+						originalPoolID = k8sPool.ID
+						return nil
+					},
+				),
+			},
+			{
+				// Changing the size replaces the pool within the same resource.
+				Config: clusterConfig + nodePoolConfig("s-2vcpu-2gb"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDigitalOceanKubernetesNodePoolExists("digitalocean_kubernetes_node_pool.barfoo", &k8s, &k8sPool),
+					resource.TestCheckResourceAttr("digitalocean_kubernetes_node_pool.barfoo", "name", rName),
+					resource.TestCheckResourceAttr("digitalocean_kubernetes_node_pool.barfoo", "size", "s-2vcpu-2gb"),
+					resource.TestCheckResourceAttr("digitalocean_kubernetes_node_pool.barfoo", "nodes.0.status", "running"),
+					func(s *terraform.State) error {
+						if k8sPool.ID == originalPoolID {
+							return fmt.Errorf("node pool %s was not replaced", originalPoolID)
+						}
+
+						client := acceptance.TestAccProvider.Meta().(*config.CombinedConfig).GodoClient()
+						_, resp, err := client.Kubernetes.GetNodePool(context.Background(), k8s.ID, originalPoolID)
+						if err == nil || resp == nil || resp.StatusCode != http.StatusNotFound {
+							return fmt.Errorf("replaced node pool %s still exists", originalPoolID)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestAccDigitalOceanKubernetesNodePool_Recycle(t *testing.T) {
+	rName := acceptance.RandomTestName()
+	var k8s godo.KubernetesCluster
+	var k8sPool godo.KubernetesNodePool
+	var originalNodeID string
+
+	clusterConfig := fmt.Sprintf(`%s
+resource "digitalocean_kubernetes_cluster" "foobar" {
+  name    = "%s"
+  region  = "lon1"
+  version = data.digitalocean_kubernetes_versions.test.latest_version
+  ha      = false
+
+  node_pool {
+    name       = "default"
+    size       = "s-1vcpu-2gb"
+    node_count = 1
+  }
+}
+`, testClusterVersionLatest, rName)
+
+	nodePoolConfig := func(trigger string) string {
+		return fmt.Sprintf(`resource "digitalocean_kubernetes_node_pool" "barfoo" {
+  cluster_id = digitalocean_kubernetes_cluster.foobar.id
+
+  name            = "%s"
+  size            = "s-1vcpu-2gb"
+  node_count      = 1
+  recycle_trigger = "%s"
+}
+`, rName, trigger)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanKubernetesClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: clusterConfig + nodePoolConfig("initial"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDigitalOceanKubernetesClusterExists("digitalocean_kubernetes_cluster.foobar", &k8s),
+					testAccCheckDigitalOceanKubernetesNodePoolExists("digitalocean_kubernetes_node_pool.barfoo", &k8s, &k8sPool),
+					func(s *terraform.State) error {
+						originalNodeID = k8sPool.Nodes[0].ID
+						return nil
+					},
+				),
+			},
+			{
+				// Changing the trigger recycles the pool's nodes.
+				Config: clusterConfig + nodePoolConfig("cve-advisory"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDigitalOceanKubernetesNodePoolExists("digitalocean_kubernetes_node_pool.barfoo", &k8s, &k8sPool),
+					resource.TestCheckResourceAttr("digitalocean_kubernetes_node_pool.barfoo", "nodes.#", "1"),
+					resource.TestCheckResourceAttr("digitalocean_kubernetes_node_pool.barfoo", "nodes.0.status", "running"),
+					func(s *terraform.State) error {
+						if k8sPool.Nodes[0].ID == originalNodeID {
+							return fmt.Errorf("node %s was not recycled", originalNodeID)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
 func TestAccDigitalOceanKubernetesNodePool_MinNodesZero(t *testing.T) {
 	rName := acceptance.RandomTestName()
 	var k8s godo.KubernetesCluster
@@ -0,0 +1,112 @@
+package monitoring
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const dropletBandwidthMetric = "bandwidth"
+
+type dropletMetricsFunc func(godo.MonitoringService, context.Context, *godo.DropletMetricsRequest) (*godo.MetricsResponse, *godo.Response, error)
+
+var dropletMetrics = map[string]dropletMetricsFunc{
+	"cpu":              godo.MonitoringService.GetDropletCPU,
+	"load_1":           godo.MonitoringService.GetDropletLoad1,
+	"load_5":           godo.MonitoringService.GetDropletLoad5,
+	"load_15":          godo.MonitoringService.GetDropletLoad15,
+	"memory_available": godo.MonitoringService.GetDropletAvailableMemory,
+	"memory_cached":    godo.MonitoringService.GetDropletCachedMemory,
+	"memory_free":      godo.MonitoringService.GetDropletFreeMemory,
+	"memory_total":     godo.MonitoringService.GetDropletTotalMemory,
+	"filesystem_free":  godo.MonitoringService.GetDropletFilesystemFree,
+	"filesystem_size":  godo.MonitoringService.GetDropletFilesystemSize,
+}
+
+func DataSourceDigitalOceanDropletMetrics() *schema.Resource {
+	metricNames := []string{dropletBandwidthMetric}
+	for name := range dropletMetrics {
+		metricNames = append(metricNames, name)
+	}
+	slices.Sort(metricNames)
+
+	dsSchema := MetricsSchema()
+	dsSchema["droplet_id"] = &schema.Schema{
+		Type:         schema.TypeInt,
+		Required:     true,
+		ValidateFunc: validation.NoZeroValues,
+		Description:  "The ID of the Droplet.",
+	}
+	dsSchema["metric"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		ValidateFunc: validation.StringInSlice(metricNames, false),
+		Description:  "The name of the metric to query.",
+	}
+	dsSchema["interface"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Default:      "public",
+		ValidateFunc: validation.StringInSlice([]string{"public", "private"}, false),
+		Description:  "The network interface to query when metric is bandwidth.",
+	}
+	dsSchema["direction"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Default:      "outbound",
+		ValidateFunc: validation.StringInSlice([]string{"inbound", "outbound"}, false),
+		Description:  "The traffic direction to query when metric is bandwidth.",
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceDigitalOceanDropletMetricsRead,
+		Schema:      dsSchema,
+	}
+}
+
+func dataSourceDigitalOceanDropletMetricsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	start, end, err := ExpandMetricsTimeRange(d)
+	if err != nil {
+		return diag.Errorf("Invalid time range: %s", err)
+	}
+
+	dropletID := strconv.Itoa(d.Get("droplet_id").(int))
+	metric := d.Get("metric").(string)
+
+	req := godo.DropletMetricsRequest{
+		HostID: dropletID,
+		Start:  start,
+		End:    end,
+	}
+
+	var resp *godo.MetricsResponse
+	if metric == dropletBandwidthMetric {
+		resp, _, err = client.Monitoring.GetDropletBandwidth(ctx, &godo.DropletBandwidthMetricsRequest{
+			DropletMetricsRequest: req,
+			Interface:             d.Get("interface").(string),
+			Direction:             d.Get("direction").(string),
+		})
+	} else {
+		resp, _, err = dropletMetrics[metric](client.Monitoring, ctx, &req)
+	}
+	if err != nil {
+		return diag.Errorf("Error retrieving %s metrics for droplet (%s): %s", metric, dropletID, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s-%s-%d-%d", dropletID, metric, start.Unix(), end.Unix()))
+
+	if err := SetMetricsAttributes(d, resp); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,49 @@
+package monitoring_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceDigitalOceanDropletMetrics_Basic(t *testing.T) {
+	name := acceptance.RandomTestName()
+	resourceConfig := fmt.Sprintf(`
+resource "digitalocean_droplet" "foo" {
+  image      = "ubuntu-22-04-x64"
+  name       = "%s"
+  region     = "nyc3"
+  size       = "s-1vcpu-1gb"
+  monitoring = true
+}`, name)
+	dataSourceConfig := `
+data "digitalocean_droplet_metrics" "foobar" {
+  droplet_id  = digitalocean_droplet.foo.id
+  metric      = "load_5"
+  window      = "30m"
+  aggregation = "max"
+}`
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: resourceConfig,
+			},
+			{
+				Config: resourceConfig + dataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.digitalocean_droplet_metrics.foobar", "metric", "load_5"),
+					resource.TestCheckResourceAttrSet(
+						"data.digitalocean_droplet_metrics.foobar", "sample_count"),
+					resource.TestCheckResourceAttrSet(
+						"data.digitalocean_droplet_metrics.foobar", "series.#"),
+				),
+			},
+		},
+	})
+}
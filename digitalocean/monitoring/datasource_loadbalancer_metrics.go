@@ -0,0 +1,101 @@
+package monitoring
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+type loadBalancerMetricsFunc func(godo.MonitoringService, context.Context, *godo.LoadBalancerMetricsRequest) (*godo.MetricsResponse, *godo.Response, error)
+
+var loadBalancerMetrics = map[string]loadBalancerMetricsFunc{
+	"frontend_http_requests_per_second":             godo.MonitoringService.GetLoadBalancerFrontendHttpRequestsPerSecond,
+	"frontend_connections_current":                  godo.MonitoringService.GetLoadBalancerFrontendConnectionsCurrent,
+	"frontend_connections_limit":                    godo.MonitoringService.GetLoadBalancerFrontendConnectionsLimit,
+	"frontend_cpu_utilization":                      godo.MonitoringService.GetLoadBalancerFrontendCpuUtilization,
+	"frontend_network_throughput_http":              godo.MonitoringService.GetLoadBalancerFrontendNetworkThroughputHttp,
+	"frontend_network_throughput_udp":               godo.MonitoringService.GetLoadBalancerFrontendNetworkThroughputUdp,
+	"frontend_network_throughput_tcp":               godo.MonitoringService.GetLoadBalancerFrontendNetworkThroughputTcp,
+	"frontend_nlb_tcp_network_throughput":           godo.MonitoringService.GetLoadBalancerFrontendNlbTcpNetworkThroughput,
+	"frontend_nlb_udp_network_throughput":           godo.MonitoringService.GetLoadBalancerFrontendNlbUdpNetworkThroughput,
+	"frontend_firewall_dropped_bytes":               godo.MonitoringService.GetLoadBalancerFrontendFirewallDroppedBytes,
+	"frontend_firewall_dropped_packets":             godo.MonitoringService.GetLoadBalancerFrontendFirewallDroppedPackets,
+	"frontend_http_responses":                       godo.MonitoringService.GetLoadBalancerFrontendHttpResponses,
+	"frontend_tls_connections_current":              godo.MonitoringService.GetLoadBalancerFrontendTlsConnectionsCurrent,
+	"frontend_tls_connections_limit":                godo.MonitoringService.GetLoadBalancerFrontendTlsConnectionsLimit,
+	"frontend_tls_connections_exceeding_rate_limit": godo.MonitoringService.GetLoadBalancerFrontendTlsConnectionsExceedingRateLimit,
+	"droplets_http_session_duration_avg":            godo.MonitoringService.GetLoadBalancerDropletsHttpSessionDurationAvg,
+	"droplets_http_session_duration_50p":            godo.MonitoringService.GetLoadBalancerDropletsHttpSessionDuration50P,
+	"droplets_http_session_duration_95p":            godo.MonitoringService.GetLoadBalancerDropletsHttpSessionDuration95P,
+	"droplets_http_response_time_avg":               godo.MonitoringService.GetLoadBalancerDropletsHttpResponseTimeAvg,
+	"droplets_http_response_time_50p":               godo.MonitoringService.GetLoadBalancerDropletsHttpResponseTime50P,
+	"droplets_http_response_time_95p":               godo.MonitoringService.GetLoadBalancerDropletsHttpResponseTime95P,
+	"droplets_http_response_time_99p":               godo.MonitoringService.GetLoadBalancerDropletsHttpResponseTime99P,
+	"droplets_queue_size":                           godo.MonitoringService.GetLoadBalancerDropletsQueueSize,
+	"droplets_http_responses":                       godo.MonitoringService.GetLoadBalancerDropletsHttpResponses,
+	"droplets_connections":                          godo.MonitoringService.GetLoadBalancerDropletsConnections,
+	"droplets_health_checks":                        godo.MonitoringService.GetLoadBalancerDropletsHealthChecks,
+	"droplets_downtime":                             godo.MonitoringService.GetLoadBalancerDropletsDowntime,
+}
+
+func DataSourceDigitalOceanLoadBalancerMetrics() *schema.Resource {
+	metricNames := make([]string, 0, len(loadBalancerMetrics))
+	for name := range loadBalancerMetrics {
+		metricNames = append(metricNames, name)
+	}
+	slices.Sort(metricNames)
+
+	dsSchema := MetricsSchema()
+	dsSchema["loadbalancer_id"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		ValidateFunc: validation.NoZeroValues,
+		Description:  "The ID of the load balancer.",
+	}
+	dsSchema["metric"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		ValidateFunc: validation.StringInSlice(metricNames, false),
+		Description:  "The name of the metric to query.",
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceDigitalOceanLoadBalancerMetricsRead,
+		Schema:      dsSchema,
+	}
+}
+
+func dataSourceDigitalOceanLoadBalancerMetricsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	start, end, err := ExpandMetricsTimeRange(d)
+	if err != nil {
+		return diag.Errorf("Invalid time range: %s", err)
+	}
+
+	lbID := d.Get("loadbalancer_id").(string)
+	metric := d.Get("metric").(string)
+
+	resp, _, err := loadBalancerMetrics[metric](client.Monitoring, ctx, &godo.LoadBalancerMetricsRequest{
+		LoadBalancerID: lbID,
+		Start:          start,
+		End:            end,
+	})
+	if err != nil {
+		return diag.Errorf("Error retrieving %s metrics for load balancer (%s): %s", metric, lbID, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s-%s-%d-%d", lbID, metric, start.Unix(), end.Unix()))
+
+	if err := SetMetricsAttributes(d, resp); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
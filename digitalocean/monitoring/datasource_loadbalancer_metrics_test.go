@@ -0,0 +1,58 @@
+package monitoring_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceDigitalOceanLoadBalancerMetrics_Basic(t *testing.T) {
+	name := acceptance.RandomTestName()
+	resourceConfig := fmt.Sprintf(`
+resource "digitalocean_loadbalancer" "foo" {
+  name   = "%s"
+  region = "nyc3"
+
+  forwarding_rule {
+    entry_port     = 80
+    entry_protocol = "http"
+
+    target_port     = 80
+    target_protocol = "http"
+  }
+
+  healthcheck {
+    port     = 22
+    protocol = "tcp"
+  }
+}`, name)
+	dataSourceConfig := `
+data "digitalocean_loadbalancer_metrics" "foobar" {
+  loadbalancer_id = digitalocean_loadbalancer.foo.id
+  metric          = "frontend_http_requests_per_second"
+  aggregation     = "sum"
+}`
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: resourceConfig,
+			},
+			{
+				Config: resourceConfig + dataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.digitalocean_loadbalancer_metrics.foobar", "metric", "frontend_http_requests_per_second"),
+					resource.TestCheckResourceAttrSet(
+						"data.digitalocean_loadbalancer_metrics.foobar", "sample_count"),
+					resource.TestCheckResourceAttrSet(
+						"data.digitalocean_loadbalancer_metrics.foobar", "values.#"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,324 @@
+package monitoring
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/godo/metrics"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const defaultMetricsWindow = time.Hour
+
+// Supported methods for combining multiple series into a single value at
+// each timestamp.
+const (
+	MetricsAggregationAvg = "avg"
+	MetricsAggregationMax = "max"
+	MetricsAggregationMin = "min"
+	MetricsAggregationSum = "sum"
+)
+
+// MetricsSchema returns the time range, aggregation, and result attributes
+// shared by the metrics data sources.
+func MetricsSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"start": {
+			Type:          schema.TypeString,
+			Optional:      true,
+			ValidateFunc:  validation.IsRFC3339Time,
+			ConflictsWith: []string{"window"},
+			Description:   "The start of the time range to query in RFC3339 format.",
+		},
+		"end": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.IsRFC3339Time,
+			Description:  "The end of the time range to query in RFC3339 format. Defaults to the current time.",
+		},
+		"window": {
+			Type:          schema.TypeString,
+			Optional:      true,
+			ValidateFunc:  validateMetricsWindow,
+			ConflictsWith: []string{"start"},
+			Description:   "The length of the time range ending at `end` to query, e.g. `1h` or `30m`. Defaults to `1h`.",
+		},
+		"aggregation": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Default:  MetricsAggregationAvg,
+			ValidateFunc: validation.StringInSlice([]string{
+				MetricsAggregationAvg,
+				MetricsAggregationMax,
+				MetricsAggregationMin,
+				MetricsAggregationSum,
+			}, false),
+			Description: "How multiple series are combined at each timestamp before computing the summary statistics.",
+		},
+		"sample_count": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "The number of aggregated values. The summary statistics are null when it is 0.",
+		},
+		"average": {
+			Type:        schema.TypeFloat,
+			Computed:    true,
+			Description: "The average of the aggregated values.",
+		},
+		"maximum": {
+			Type:        schema.TypeFloat,
+			Computed:    true,
+			Description: "The maximum of the aggregated values.",
+		},
+		"minimum": {
+			Type:        schema.TypeFloat,
+			Computed:    true,
+			Description: "The minimum of the aggregated values.",
+		},
+		"p95": {
+			Type:        schema.TypeFloat,
+			Computed:    true,
+			Description: "The 95th percentile of the aggregated values.",
+		},
+		"latest": {
+			Type:        schema.TypeFloat,
+			Computed:    true,
+			Description: "The most recent aggregated value.",
+		},
+		"values": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "The aggregated values ordered by timestamp.",
+			Elem: &schema.Resource{
+				Schema: metricsSampleSchema(),
+			},
+		},
+		"series": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "The raw series returned by the API.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"labels": {
+						Type:     schema.TypeMap,
+						Computed: true,
+						Elem:     &schema.Schema{Type: schema.TypeString},
+					},
+					"values": {
+						Type:     schema.TypeList,
+						Computed: true,
+						Elem: &schema.Resource{
+							Schema: metricsSampleSchema(),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func metricsSampleSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"timestamp": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"value": {
+			Type:     schema.TypeFloat,
+			Computed: true,
+		},
+	}
+}
+
+func validateMetricsWindow(v interface{}, k string) (ws []string, es []error) {
+	window, err := time.ParseDuration(v.(string))
+	if err != nil {
+		es = append(es, fmt.Errorf("%q must be a valid duration: %s", k, err))
+		return
+	}
+	if window <= 0 {
+		es = append(es, fmt.Errorf("%q must be a positive duration", k))
+	}
+	return
+}
+
+// ExpandMetricsTimeRange returns the start and end of the time range to query
+// based on the start, end, and window attributes.
+func ExpandMetricsTimeRange(d *schema.ResourceData) (time.Time, time.Time, error) {
+	end := time.Now().UTC()
+	if v, ok := d.GetOk("end"); ok {
+		parsed, err := time.Parse(time.RFC3339, v.(string))
+		if err != nil {
+			return time.Time{}, time.Time{}, err
+		}
+		end = parsed
+	}
+
+	start := end.Add(-defaultMetricsWindow)
+	if v, ok := d.GetOk("start"); ok {
+		parsed, err := time.Parse(time.RFC3339, v.(string))
+		if err != nil {
+			return time.Time{}, time.Time{}, err
+		}
+		start = parsed
+	} else if v, ok := d.GetOk("window"); ok {
+		window, err := time.ParseDuration(v.(string))
+		if err != nil {
+			return time.Time{}, time.Time{}, err
+		}
+		start = end.Add(-window)
+	}
+
+	if !start.Before(end) {
+		return time.Time{}, time.Time{}, errors.New("start must be before end")
+	}
+
+	return start, end, nil
+}
+
+// SetMetricsAttributes sets the summary statistics, aggregated values, and raw
+// series from a metrics response.
+func SetMetricsAttributes(d *schema.ResourceData, resp *godo.MetricsResponse) error {
+	var streams []metrics.SampleStream
+	if resp != nil {
+		streams = resp.Data.Result
+	}
+
+	values := aggregateMetricsSeries(streams, d.Get("aggregation").(string))
+	d.Set("sample_count", len(values))
+
+	// Without any samples the summary statistics are left unset, so they are
+	// null rather than indistinguishable from a real value of 0.
+	if len(values) > 0 {
+		summary := summarizeMetricsValues(values)
+		d.Set("average", summary.average)
+		d.Set("maximum", summary.maximum)
+		d.Set("minimum", summary.minimum)
+		d.Set("p95", summary.p95)
+		d.Set("latest", summary.latest)
+	}
+
+	if err := d.Set("values", flattenMetricsSamples(values)); err != nil {
+		return fmt.Errorf("Error setting `values`: %+v", err)
+	}
+
+	series := make([]map[string]interface{}, 0, len(streams))
+	for _, stream := range streams {
+		labels := make(map[string]interface{}, len(stream.Metric))
+		for k, v := range stream.Metric {
+			labels[string(k)] = string(v)
+		}
+
+		series = append(series, map[string]interface{}{
+			"labels": labels,
+			"values": flattenMetricsSamples(stream.Values),
+		})
+	}
+
+	if err := d.Set("series", series); err != nil {
+		return fmt.Errorf("Error setting `series`: %+v", err)
+	}
+
+	return nil
+}
+
+// aggregateMetricsSeries combines the values of all series sharing a
+// timestamp into a single value ordered by timestamp.
+func aggregateMetricsSeries(streams []metrics.SampleStream, aggregation string) []metrics.SamplePair {
+	grouped := make(map[metrics.Time][]float64)
+	for _, stream := range streams {
+		for _, sample := range stream.Values {
+			value := float64(sample.Value)
+			if math.IsNaN(value) {
+				continue
+			}
+			grouped[sample.Timestamp] = append(grouped[sample.Timestamp], value)
+		}
+	}
+
+	timestamps := make([]metrics.Time, 0, len(grouped))
+	for ts := range grouped {
+		timestamps = append(timestamps, ts)
+	}
+	sort.Slice(timestamps, func(i, j int) bool { return timestamps[i].Before(timestamps[j]) })
+
+	aggregated := make([]metrics.SamplePair, 0, len(timestamps))
+	for _, ts := range timestamps {
+		samples := grouped[ts]
+
+		var value float64
+		switch aggregation {
+		case MetricsAggregationMax:
+			value = slices.Max(samples)
+		case MetricsAggregationMin:
+			value = slices.Min(samples)
+		case MetricsAggregationSum:
+			for _, s := range samples {
+				value += s
+			}
+		default:
+			for _, s := range samples {
+				value += s
+			}
+			value = value / float64(len(samples))
+		}
+
+		aggregated = append(aggregated, metrics.SamplePair{
+			Timestamp: ts,
+			Value:     metrics.SampleValue(value),
+		})
+	}
+
+	return aggregated
+}
+
+type metricsSummary struct {
+	average float64
+	maximum float64
+	minimum float64
+	p95     float64
+	latest  float64
+}
+
+// summarizeMetricsValues computes the summary statistics of a non-empty list
+// of values.
+func summarizeMetricsValues(values []metrics.SamplePair) metricsSummary {
+	var summary metricsSummary
+
+	sorted := make([]float64, len(values))
+	var total float64
+	for i, sample := range values {
+		sorted[i] = float64(sample.Value)
+		total += sorted[i]
+	}
+	slices.Sort(sorted)
+
+	// Nearest-rank percentile.
+	rank := int(math.Ceil(0.95*float64(len(sorted)))) - 1
+
+	summary.average = total / float64(len(sorted))
+	summary.maximum = sorted[len(sorted)-1]
+	summary.minimum = sorted[0]
+	summary.p95 = sorted[rank]
+	summary.latest = float64(values[len(values)-1].Value)
+
+	return summary
+}
+
+func flattenMetricsSamples(samples []metrics.SamplePair) []map[string]interface{} {
+	flattened := make([]map[string]interface{}, 0, len(samples))
+	for _, sample := range samples {
+		flattened = append(flattened, map[string]interface{}{
+			"timestamp": sample.Timestamp.Time().UTC().Format(time.RFC3339),
+			"value":     float64(sample.Value),
+		})
+	}
+
+	return flattened
+}
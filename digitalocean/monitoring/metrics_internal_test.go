@@ -0,0 +1,103 @@
+package monitoring
+
+import (
+	"testing"
+	"time"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/godo/metrics"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAggregateMetricsSeries(t *testing.T) {
+	streams := []metrics.SampleStream{
+		{
+			Metric: metrics.Metric{"mode": "user"},
+			Values: []metrics.SamplePair{
+				{Timestamp: 2000, Value: 4},
+				{Timestamp: 1000, Value: 2},
+			},
+		},
+		{
+			Metric: metrics.Metric{"mode": "system"},
+			Values: []metrics.SamplePair{
+				{Timestamp: 1000, Value: 6},
+				{Timestamp: 2000, Value: 8},
+			},
+		},
+	}
+
+	cases := map[string][]float64{
+		MetricsAggregationAvg: {4, 6},
+		MetricsAggregationMax: {6, 8},
+		MetricsAggregationMin: {2, 4},
+		MetricsAggregationSum: {8, 12},
+	}
+
+	for aggregation, expected := range cases {
+		t.Run(aggregation, func(t *testing.T) {
+			values := aggregateMetricsSeries(streams, aggregation)
+			if assert.Len(t, values, 2) {
+				assert.Equal(t, metrics.Time(1000), values[0].Timestamp)
+				assert.Equal(t, expected[0], float64(values[0].Value))
+				assert.Equal(t, expected[1], float64(values[1].Value))
+			}
+		})
+	}
+}
+
+func TestSummarizeMetricsValues(t *testing.T) {
+	var values []metrics.SamplePair
+	for i := 1; i <= 20; i++ {
+		values = append(values, metrics.SamplePair{
+			Timestamp: metrics.Time(i * 1000),
+			Value:     metrics.SampleValue(21 - i),
+		})
+	}
+
+	summary := summarizeMetricsValues(values)
+	assert.Equal(t, 10.5, summary.average)
+	assert.Equal(t, 20.0, summary.maximum)
+	assert.Equal(t, 1.0, summary.minimum)
+	assert.Equal(t, 19.0, summary.p95)
+	assert.Equal(t, 1.0, summary.latest)
+}
+
+func TestSetMetricsAttributesEmpty(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, MetricsSchema(), map[string]interface{}{})
+	d.SetId("metrics")
+
+	if err := SetMetricsAttributes(d, &godo.MetricsResponse{}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	attributes := d.State().Attributes
+	assert.Equal(t, "0", attributes["sample_count"])
+	for _, k := range []string{"average", "maximum", "minimum", "p95", "latest"} {
+		assert.NotContains(t, attributes, k)
+	}
+}
+
+func TestExpandMetricsTimeRange(t *testing.T) {
+	s := MetricsSchema()
+
+	d := schema.TestResourceDataRaw(t, s, map[string]interface{}{
+		"end":    "2025-01-02T15:00:00Z",
+		"window": "30m",
+	})
+	start, end, err := ExpandMetricsTimeRange(d)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	assert.Equal(t, time.Date(2025, 1, 2, 15, 0, 0, 0, time.UTC), end)
+	assert.Equal(t, time.Date(2025, 1, 2, 14, 30, 0, 0, time.UTC), start)
+
+	d = schema.TestResourceDataRaw(t, s, map[string]interface{}{
+		"start": "2025-01-02T16:00:00Z",
+		"end":   "2025-01-02T15:00:00Z",
+	})
+	if _, _, err := ExpandMetricsTimeRange(d); err == nil {
+		t.Fatal("expected an error when start is after end")
+	}
+}
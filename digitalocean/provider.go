@@ -112,10 +112,15 @@ func Provider() *schema.Provider {
 			"digitalocean_certificate":                             certificate.DataSourceDigitalOceanCertificate(),
 			"digitalocean_container_registry":                      registry.DataSourceDigitalOceanContainerRegistry(),
 			"digitalocean_container_registries":                    registry.DataSourceDigitalOceanContainerRegistries(),
+			"digitalocean_database_backups":                        database.DataSourceDigitalOceanDatabaseBackups(),
 			"digitalocean_database_cluster":                        database.DataSourceDigitalOceanDatabaseCluster(),
 			"digitalocean_database_connection_pool":                database.DataSourceDigitalOceanDatabaseConnectionPool(),
 			"digitalocean_database_ca":                             database.DataSourceDigitalOceanDatabaseCA(),
+			"digitalocean_database_events":                         database.DataSourceDigitalOceanDatabaseEvents(),
+			"digitalocean_database_metrics":                        database.DataSourceDigitalOceanDatabaseMetrics(),
 			"digitalocean_database_metrics_credentials":            database.DataSourceDigitalOceanDatabaseMetricsCredentials(),
+			"digitalocean_database_opensearch_indexes":             database.DataSourceDigitalOceanDatabaseOpensearchIndexes(),
+			"digitalocean_database_options":                        database.DataSourceDigitalOceanDatabaseOptions(),
 			"digitalocean_database_replica":                        database.DataSourceDigitalOceanDatabaseReplica(),
 			"digitalocean_database_user":                           database.DataSourceDigitalOceanDatabaseUser(),
 			"digitalocean_vector_database":                         database.DataSourceDigitalOceanVectorDatabase(),
@@ -123,15 +128,27 @@ func Provider() *schema.Provider {
 			"digitalocean_domains":                                 domain.DataSourceDigitalOceanDomains(),
 			"digitalocean_droplet":                                 droplet.DataSourceDigitalOceanDroplet(),
 			"digitalocean_droplet_autoscale":                       dropletautoscale.DataSourceDigitalOceanDropletAutoscale(),
+			"digitalocean_droplet_autoscale_history":               dropletautoscale.DataSourceDigitalOceanDropletAutoscaleHistory(),
+			"digitalocean_droplet_autoscale_members":               dropletautoscale.DataSourceDigitalOceanDropletAutoscaleMembers(),
+			"digitalocean_droplet_kernels":                         droplet.DataSourceDigitalOceanDropletKernels(),
 			"digitalocean_droplets":                                droplet.DataSourceDigitalOceanDroplets(),
 			"digitalocean_droplet_snapshot":                        snapshot.DataSourceDigitalOceanDropletSnapshot(),
+			"digitalocean_droplet_supported_backup_policies":       droplet.DataSourceDigitalOceanDropletSupportedBackupPolicies(),
+			"digitalocean_droplet_metrics":                         monitoring.DataSourceDigitalOceanDropletMetrics(),
 			"digitalocean_firewall":                                firewall.DataSourceDigitalOceanFirewall(),
 			"digitalocean_floating_ip":                             reservedip.DataSourceDigitalOceanFloatingIP(),
 			"digitalocean_image":                                   image.DataSourceDigitalOceanImage(),
 			"digitalocean_images":                                  image.DataSourceDigitalOceanImages(),
 			"digitalocean_kubernetes_cluster":                      kubernetes.DataSourceDigitalOceanKubernetesCluster(),
+			"digitalocean_kubernetes_cluster_credentials":          kubernetes.DataSourceDigitalOceanKubernetesClusterCredentials(),
+			"digitalocean_kubernetes_cluster_status":               kubernetes.DataSourceDigitalOceanKubernetesClusterStatus(),
+			"digitalocean_kubernetes_cluster_upgrades":             kubernetes.DataSourceDigitalOceanKubernetesClusterUpgrades(),
+			"digitalocean_kubernetes_clusterlint":                  kubernetes.DataSourceDigitalOceanKubernetesClusterlint(),
+			"digitalocean_kubernetes_node_pool_template":           kubernetes.DataSourceDigitalOceanKubernetesNodePoolTemplate(),
+			"digitalocean_kubernetes_options":                      kubernetes.DataSourceDigitalOceanKubernetesOptions(),
 			"digitalocean_kubernetes_versions":                     kubernetes.DataSourceDigitalOceanKubernetesVersions(),
 			"digitalocean_loadbalancer":                            loadbalancer.DataSourceDigitalOceanLoadbalancer(),
+			"digitalocean_loadbalancer_metrics":                    monitoring.DataSourceDigitalOceanLoadBalancerMetrics(),
 			"digitalocean_project":                                 project.DataSourceDigitalOceanProject(),
 			"digitalocean_projects":                                project.DataSourceDigitalOceanProjects(),
 			"digitalocean_record":                                  domain.DataSourceDigitalOceanRecord(),
@@ -185,85 +202,90 @@ func Provider() *schema.Provider {
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
-			"digitalocean_app":                                        app.ResourceDigitalOceanApp(),
-			"digitalocean_byoip_prefix":                               byoipprefix.ResourceBYOIPPrefix(),
-			"digitalocean_certificate":                                certificate.ResourceDigitalOceanCertificate(),
-			"digitalocean_container_registry":                         registry.ResourceDigitalOceanContainerRegistry(),
-			"digitalocean_container_registries":                       registry.ResourceDigitalOceanContainerRegistries(),
-			"digitalocean_container_registry_docker_credentials":      registry.ResourceDigitalOceanContainerRegistryDockerCredentials(),
-			"digitalocean_cdn":                                        cdn.ResourceDigitalOceanCDN(),
-			"digitalocean_database_cluster":                           database.ResourceDigitalOceanDatabaseCluster(),
-			"digitalocean_database_connection_pool":                   database.ResourceDigitalOceanDatabaseConnectionPool(),
-			"digitalocean_database_db":                                database.ResourceDigitalOceanDatabaseDB(),
-			"digitalocean_database_firewall":                          database.ResourceDigitalOceanDatabaseFirewall(),
-			"digitalocean_database_replica":                           database.ResourceDigitalOceanDatabaseReplica(),
-			"digitalocean_database_user":                              database.ResourceDigitalOceanDatabaseUser(),
-			"digitalocean_vector_database":                            database.ResourceDigitalOceanVectorDatabase(),
-			"digitalocean_database_redis_config":                      database.ResourceDigitalOceanDatabaseRedisConfig(),
-			"digitalocean_database_valkey_config":                     database.ResourceDigitalOceanDatabaseValkeyConfig(),
-			"digitalocean_database_postgresql_config":                 database.ResourceDigitalOceanDatabasePostgreSQLConfig(),
-			"digitalocean_database_advanced_postgresql_config":        database.ResourceDigitalOceanDatabaseAdvancedPostgreSQLConfig(),
-			"digitalocean_database_advanced_mysql_config":             database.ResourceDigitalOceanDatabaseAdvancedMySQLConfig(),
-			"digitalocean_database_mysql_config":                      database.ResourceDigitalOceanDatabaseMySQLConfig(),
-			"digitalocean_database_mongodb_config":                    database.ResourceDigitalOceanDatabaseMongoDBConfig(),
-			"digitalocean_database_kafka_config":                      database.ResourceDigitalOceanDatabaseKafkaConfig(),
-			"digitalocean_database_opensearch_config":                 database.ResourceDigitalOceanDatabaseOpensearchConfig(),
-			"digitalocean_database_kafka_topic":                       database.ResourceDigitalOceanDatabaseKafkaTopic(),
-			"digitalocean_database_kafka_schema_registry":             database.ResourceDigitalOceanDatabaseKafkaSchemaRegistry(),
-			"digitalocean_database_online_migration":                  database.ResourceDigitalOceanDatabaseOnlineMigration(),
-			"digitalocean_database_logsink_rsyslog":                   database.ResourceDigitalOceanDatabaseLogsinkRsyslog(),
-			"digitalocean_database_logsink_opensearch":                database.ResourceDigitalOceanDatabaseLogsinkOpensearch(),
-			"digitalocean_domain":                                     domain.ResourceDigitalOceanDomain(),
-			"digitalocean_droplet":                                    droplet.ResourceDigitalOceanDroplet(),
-			"digitalocean_droplet_autoscale":                          dropletautoscale.ResourceDigitalOceanDropletAutoscale(),
-			"digitalocean_droplet_snapshot":                           snapshot.ResourceDigitalOceanDropletSnapshot(),
-			"digitalocean_firewall":                                   firewall.ResourceDigitalOceanFirewall(),
-			"digitalocean_floating_ip":                                reservedip.ResourceDigitalOceanFloatingIP(),
-			"digitalocean_floating_ip_assignment":                     reservedip.ResourceDigitalOceanFloatingIPAssignment(),
-			"digitalocean_kubernetes_cluster":                         kubernetes.ResourceDigitalOceanKubernetesCluster(),
-			"digitalocean_kubernetes_node_pool":                       kubernetes.ResourceDigitalOceanKubernetesNodePool(),
-			"digitalocean_loadbalancer":                               loadbalancer.ResourceDigitalOceanLoadbalancer(),
-			"digitalocean_monitor_alert":                              monitoring.ResourceDigitalOceanMonitorAlert(),
-			"digitalocean_project":                                    project.ResourceDigitalOceanProject(),
-			"digitalocean_project_resources":                          project.ResourceDigitalOceanProjectResources(),
-			"digitalocean_record":                                     domain.ResourceDigitalOceanRecord(),
-			"digitalocean_reserved_ip":                                reservedip.ResourceDigitalOceanReservedIP(),
-			"digitalocean_reserved_ip_assignment":                     reservedip.ResourceDigitalOceanReservedIPAssignment(),
-			"digitalocean_reserved_ipv6":                              reservedipv6.ResourceDigitalOceanReservedIPV6(),
-			"digitalocean_reserved_ipv6_assignment":                   reservedipv6.ResourceDigitalOceanReservedIPV6Assignment(),
-			"digitalocean_spaces_bucket":                              spaces.ResourceDigitalOceanBucket(),
-			"digitalocean_spaces_bucket_cors_configuration":           spaces.ResourceDigitalOceanBucketCorsConfiguration(),
-			"digitalocean_spaces_bucket_object":                       spaces.ResourceDigitalOceanSpacesBucketObject(),
-			"digitalocean_spaces_bucket_policy":                       spaces.ResourceDigitalOceanSpacesBucketPolicy(),
-			"digitalocean_spaces_key":                                 spaces.ResourceDigitalOceanSpacesKey(),
-			"digitalocean_spaces_bucket_logging":                      spaces.ResourceDigitalOceanSpacesBucketLogging(),
-			"digitalocean_ssh_key":                                    sshkey.ResourceDigitalOceanSSHKey(),
-			"digitalocean_tag":                                        tag.ResourceDigitalOceanTag(),
-			"digitalocean_uptime_check":                               uptime.ResourceDigitalOceanUptimeCheck(),
-			"digitalocean_uptime_alert":                               uptime.ResourceDigitalOceanUptimeAlert(),
-			"digitalocean_volume":                                     volume.ResourceDigitalOceanVolume(),
-			"digitalocean_volume_attachment":                          volume.ResourceDigitalOceanVolumeAttachment(),
-			"digitalocean_volume_snapshot":                            snapshot.ResourceDigitalOceanVolumeSnapshot(),
-			"digitalocean_vpc":                                        vpc.ResourceDigitalOceanVPC(),
-			"digitalocean_vpc_nat_gateway":                            vpcnatgateway.ResourceDigitalOceanVPCNATGateway(),
-			"digitalocean_vpc_peering":                                vpcpeering.ResourceDigitalOceanVPCPeering(),
-			"digitalocean_custom_image":                               image.ResourceDigitalOceanCustomImage(),
-			"digitalocean_partner_attachment":                         partnernetworkconnect.ResourceDigitalOceanPartnerAttachment(),
-			"digitalocean_gradientai_agent":                           gradientai.ResourceDigitalOceanAgent(),
-			"digitalocean_gradientai_function":                        gradientai.ResourceDigitalOceanGradientAIFunctionRoute(),
-			"digitalocean_gradientai_agent_route":                     gradientai.ResourceDigitalOceanAgentRoute(),
-			"digitalocean_gradientai_indexing_job_cancel":             gradientai.ResourceDigitalOceanIndexingJobCancel(),
-			"digitalocean_gradientai_knowledge_base":                  gradientai.ResourceDigitalOceanKnowledgeBase(),
-			"digitalocean_gradientai_knowledge_base_data_source":      gradientai.ResourceDigitalOceanKnowledgeBaseDataSource(),
-			"digitalocean_gradientai_agent_knowledge_base_attachment": gradientai.ResourceDigitalOceanAgentKnowledgeBaseAttachment(),
-			"digitalocean_gradientai_openai_api_key":                  gradientai.ResourceDigitalOceanOpenAIApiKey(),
-			"digitalocean_gradientai_custom_model":                    gradientai.ResourceDigitalOceanCustomModel(),
-			"digitalocean_nfs":                                        nfs.ResourceDigitalOceanNfs(),
-			"digitalocean_nfs_access_point":                           nfs.ResourceDigitalOceanNfsAccessPoint(),
-			"digitalocean_nfs_attachment":                             nfs.ResourceDigitalOceanNfsAttachment(),
-			"digitalocean_nfs_snapshot":                               nfs.ResourceDigitalOceanNfsSnapshot(),
-			"digitalocean_dedicated_inference":                        dedicatedinference.ResourceDigitalOceanDedicatedInference(),
-			"digitalocean_dedicated_inference_token":                  dedicatedinference.ResourceDigitalOceanDedicatedInferenceToken(),
+			"digitalocean_app":                                           app.ResourceDigitalOceanApp(),
+			"digitalocean_byoip_prefix":                                  byoipprefix.ResourceBYOIPPrefix(),
+			"digitalocean_certificate":                                   certificate.ResourceDigitalOceanCertificate(),
+			"digitalocean_container_registry":                            registry.ResourceDigitalOceanContainerRegistry(),
+			"digitalocean_container_registries":                          registry.ResourceDigitalOceanContainerRegistries(),
+			"digitalocean_container_registry_docker_credentials":         registry.ResourceDigitalOceanContainerRegistryDockerCredentials(),
+			"digitalocean_cdn":                                           cdn.ResourceDigitalOceanCDN(),
+			"digitalocean_database_cluster":                              database.ResourceDigitalOceanDatabaseCluster(),
+			"digitalocean_database_connection_pool":                      database.ResourceDigitalOceanDatabaseConnectionPool(),
+			"digitalocean_database_db":                                   database.ResourceDigitalOceanDatabaseDB(),
+			"digitalocean_database_firewall":                             database.ResourceDigitalOceanDatabaseFirewall(),
+			"digitalocean_database_replica":                              database.ResourceDigitalOceanDatabaseReplica(),
+			"digitalocean_database_replica_promotion":                    database.ResourceDigitalOceanDatabaseReplicaPromotion(),
+			"digitalocean_database_user":                                 database.ResourceDigitalOceanDatabaseUser(),
+			"digitalocean_vector_database":                               database.ResourceDigitalOceanVectorDatabase(),
+			"digitalocean_database_redis_config":                         database.ResourceDigitalOceanDatabaseRedisConfig(),
+			"digitalocean_database_valkey_config":                        database.ResourceDigitalOceanDatabaseValkeyConfig(),
+			"digitalocean_database_postgresql_config":                    database.ResourceDigitalOceanDatabasePostgreSQLConfig(),
+			"digitalocean_database_advanced_postgresql_config":           database.ResourceDigitalOceanDatabaseAdvancedPostgreSQLConfig(),
+			"digitalocean_database_advanced_mysql_config":                database.ResourceDigitalOceanDatabaseAdvancedMySQLConfig(),
+			"digitalocean_database_mysql_config":                         database.ResourceDigitalOceanDatabaseMySQLConfig(),
+			"digitalocean_database_mongodb_config":                       database.ResourceDigitalOceanDatabaseMongoDBConfig(),
+			"digitalocean_database_kafka_config":                         database.ResourceDigitalOceanDatabaseKafkaConfig(),
+			"digitalocean_database_opensearch_config":                    database.ResourceDigitalOceanDatabaseOpensearchConfig(),
+			"digitalocean_database_opensearch_index_retention":           database.ResourceDigitalOceanDatabaseOpensearchIndexRetention(),
+			"digitalocean_database_kafka_topic":                          database.ResourceDigitalOceanDatabaseKafkaTopic(),
+			"digitalocean_database_kafka_schema_registry":                database.ResourceDigitalOceanDatabaseKafkaSchemaRegistry(),
+			"digitalocean_database_kafka_schema_registry_config":         database.ResourceDigitalOceanDatabaseKafkaSchemaRegistryConfig(),
+			"digitalocean_database_kafka_schema_registry_subject_config": database.ResourceDigitalOceanDatabaseKafkaSchemaRegistrySubjectConfig(),
+			"digitalocean_database_metrics_credentials":                  database.ResourceDigitalOceanDatabaseMetricsCredentials(),
+			"digitalocean_database_online_migration":                     database.ResourceDigitalOceanDatabaseOnlineMigration(),
+			"digitalocean_database_logsink_rsyslog":                      database.ResourceDigitalOceanDatabaseLogsinkRsyslog(),
+			"digitalocean_database_logsink_opensearch":                   database.ResourceDigitalOceanDatabaseLogsinkOpensearch(),
+			"digitalocean_domain":                                        domain.ResourceDigitalOceanDomain(),
+			"digitalocean_droplet":                                       droplet.ResourceDigitalOceanDroplet(),
+			"digitalocean_droplet_autoscale":                             dropletautoscale.ResourceDigitalOceanDropletAutoscale(),
+			"digitalocean_droplet_snapshot":                              snapshot.ResourceDigitalOceanDropletSnapshot(),
+			"digitalocean_firewall":                                      firewall.ResourceDigitalOceanFirewall(),
+			"digitalocean_floating_ip":                                   reservedip.ResourceDigitalOceanFloatingIP(),
+			"digitalocean_floating_ip_assignment":                        reservedip.ResourceDigitalOceanFloatingIPAssignment(),
+			"digitalocean_kubernetes_cluster":                            kubernetes.ResourceDigitalOceanKubernetesCluster(),
+			"digitalocean_kubernetes_node_pool":                          kubernetes.ResourceDigitalOceanKubernetesNodePool(),
+			"digitalocean_loadbalancer":                                  loadbalancer.ResourceDigitalOceanLoadbalancer(),
+			"digitalocean_monitor_alert":                                 monitoring.ResourceDigitalOceanMonitorAlert(),
+			"digitalocean_project":                                       project.ResourceDigitalOceanProject(),
+			"digitalocean_project_resources":                             project.ResourceDigitalOceanProjectResources(),
+			"digitalocean_record":                                        domain.ResourceDigitalOceanRecord(),
+			"digitalocean_reserved_ip":                                   reservedip.ResourceDigitalOceanReservedIP(),
+			"digitalocean_reserved_ip_assignment":                        reservedip.ResourceDigitalOceanReservedIPAssignment(),
+			"digitalocean_reserved_ipv6":                                 reservedipv6.ResourceDigitalOceanReservedIPV6(),
+			"digitalocean_reserved_ipv6_assignment":                      reservedipv6.ResourceDigitalOceanReservedIPV6Assignment(),
+			"digitalocean_spaces_bucket":                                 spaces.ResourceDigitalOceanBucket(),
+			"digitalocean_spaces_bucket_cors_configuration":              spaces.ResourceDigitalOceanBucketCorsConfiguration(),
+			"digitalocean_spaces_bucket_object":                          spaces.ResourceDigitalOceanSpacesBucketObject(),
+			"digitalocean_spaces_bucket_policy":                          spaces.ResourceDigitalOceanSpacesBucketPolicy(),
+			"digitalocean_spaces_key":                                    spaces.ResourceDigitalOceanSpacesKey(),
+			"digitalocean_spaces_bucket_logging":                         spaces.ResourceDigitalOceanSpacesBucketLogging(),
+			"digitalocean_ssh_key":                                       sshkey.ResourceDigitalOceanSSHKey(),
+			"digitalocean_tag":                                           tag.ResourceDigitalOceanTag(),
+			"digitalocean_uptime_check":                                  uptime.ResourceDigitalOceanUptimeCheck(),
+			"digitalocean_uptime_alert":                                  uptime.ResourceDigitalOceanUptimeAlert(),
+			"digitalocean_volume":                                        volume.ResourceDigitalOceanVolume(),
+			"digitalocean_volume_attachment":                             volume.ResourceDigitalOceanVolumeAttachment(),
+			"digitalocean_volume_snapshot":                               snapshot.ResourceDigitalOceanVolumeSnapshot(),
+			"digitalocean_vpc":                                           vpc.ResourceDigitalOceanVPC(),
+			"digitalocean_vpc_nat_gateway":                               vpcnatgateway.ResourceDigitalOceanVPCNATGateway(),
+			"digitalocean_vpc_peering":                                   vpcpeering.ResourceDigitalOceanVPCPeering(),
+			"digitalocean_custom_image":                                  image.ResourceDigitalOceanCustomImage(),
+			"digitalocean_partner_attachment":                            partnernetworkconnect.ResourceDigitalOceanPartnerAttachment(),
+			"digitalocean_gradientai_agent":                              gradientai.ResourceDigitalOceanAgent(),
+			"digitalocean_gradientai_function":                           gradientai.ResourceDigitalOceanGradientAIFunctionRoute(),
+			"digitalocean_gradientai_agent_route":                        gradientai.ResourceDigitalOceanAgentRoute(),
+			"digitalocean_gradientai_indexing_job_cancel":                gradientai.ResourceDigitalOceanIndexingJobCancel(),
+			"digitalocean_gradientai_knowledge_base":                     gradientai.ResourceDigitalOceanKnowledgeBase(),
+			"digitalocean_gradientai_knowledge_base_data_source":         gradientai.ResourceDigitalOceanKnowledgeBaseDataSource(),
+			"digitalocean_gradientai_agent_knowledge_base_attachment":    gradientai.ResourceDigitalOceanAgentKnowledgeBaseAttachment(),
+			"digitalocean_gradientai_openai_api_key":                     gradientai.ResourceDigitalOceanOpenAIApiKey(),
+			"digitalocean_gradientai_custom_model":                       gradientai.ResourceDigitalOceanCustomModel(),
+			"digitalocean_nfs":                                           nfs.ResourceDigitalOceanNfs(),
+			"digitalocean_nfs_access_point":                              nfs.ResourceDigitalOceanNfsAccessPoint(),
+			"digitalocean_nfs_attachment":                                nfs.ResourceDigitalOceanNfsAttachment(),
+			"digitalocean_nfs_snapshot":                                  nfs.ResourceDigitalOceanNfsSnapshot(),
+			"digitalocean_dedicated_inference":                           dedicatedinference.ResourceDigitalOceanDedicatedInference(),
+			"digitalocean_dedicated_inference_token":                     dedicatedinference.ResourceDigitalOceanDedicatedInferenceToken(),
 		},
 	}
 
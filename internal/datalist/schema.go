@@ -29,6 +29,10 @@ type ResourceConfig struct {
 	// function.
 	GetRecords func(meta interface{}, extra map[string]interface{}) ([]interface{}, error)
 
+	// Like GetRecords, but also given the context of the Read function. Used
+	// instead of GetRecords when set.
+	GetRecordsContext func(ctx context.Context, meta interface{}, extra map[string]interface{}) ([]interface{}, error)
+
 	// Extra parameters to expose on the datasource alongside `filter` and `sort`.
 	ExtraQuerySchema map[string]*schema.Schema
 }
@@ -84,7 +88,13 @@ func dataListResourceRead(config *ResourceConfig) schema.ReadContextFunc {
 			extra[key] = d.Get(key)
 		}
 
-		records, err := config.GetRecords(meta, extra)
+		var records []interface{}
+		var err error
+		if config.GetRecordsContext != nil {
+			records, err = config.GetRecordsContext(ctx, meta, extra)
+		} else {
+			records, err = config.GetRecords(meta, extra)
+		}
 		if err != nil {
 			return diag.Errorf("Unable to load records: %s", err)
 		}